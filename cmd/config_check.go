@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"handbooks/internal/config"
+	"log/slog"
+	"os"
+)
+
+// runConfigCheck — `handbooks config check [-config path]`: валидирует конфиг
+// и печатает итоговые значения (TOML + ENV + дефолты) с замаскированными секретами
+func runConfigCheck(args []string) int {
+	fs, path := newFlagSet("config check")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	// Служебные логи загрузки конфига не должны смешиваться с выводом
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn})))
+
+	cfg, err := config.NewConfig(context.Background(), *path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", *path, err)
+		return 1
+	}
+
+	values := cfg.Masked()
+	for _, key := range config.SortedKeys(values) {
+		fmt.Printf("%s = %s\n", key, values[key])
+	}
+
+	fmt.Fprintf(os.Stderr, "%s: OK\n", *path)
+	return 0
+}
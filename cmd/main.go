@@ -2,84 +2,103 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"handbooks/internal/config"
-	"handbooks/internal/database"
-	handlers "handbooks/internal/handler"
+	"handbooks/internal/logger"
 	"log/slog"
 	"os"
-	"os/signal"
-	"syscall"
 
-	"github.com/golang-cz/devslog"
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
-func NewDevLogger() {
-	opts := &devslog.Options{
-		MaxSlicePrintSize: 4,
-		SortKeys:          true,
-		TimeFormat:        "15:04:05.000",
-		NewLineAfterLog:   true,
-		DebugColor:        devslog.Magenta,
-		StringerFormatter: true,
-	}
+// configPath — путь к конфигу по умолчанию, относительно рабочей директории
+const configPath = "configs/config.toml"
 
-	handler := devslog.NewHandler(os.Stdout, opts)
-	logger := slog.New(handler)
+const usage = `Использование: handbooks <команда> [флаги]
 
-	slog.SetDefault(logger)
-}
+Команды:
+  serve                          запустить HTTP сервер (по умолчанию)
+  config check                   проверить конфиг и показать итоговые значения
+  migrate up|down|status|redo    управление миграциями схемы
+  seed -file <fixture>           загрузить демо-курсы из YAML/JSON
+  user create -email ... -role   создать пользователя (например, первого администратора)
+  user set-role -email ... -role сменить роль пользователя
 
-func main() {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+Флаги команды: handbooks <команда> -h
+`
 
-	NewDevLogger() // Инициализация логирования
-
-	cfg, err := config.NewConfig(ctx, "configs/config.toml")
-	if err != nil {
-		slog.Error("Не удалось загрузить конфигурацию", "error", err)
-		os.Exit(1)
+func main() {
+	args := os.Args[1:]
+	if len(args) == 0 {
+		args = []string{"serve"}
 	}
 
-	slog.SetLogLoggerLevel(cfg.Handbooks.LogLevel)
-
-	postgres, err := database.NewDatabase(ctx, cfg.Database.URL)
-	if err != nil {
-		slog.Error("Ошибка подключения к БД postgres", "err", err)
-		os.Exit(1)
+	var code int
+	switch cmd, rest := args[0], args[1:]; cmd {
+	case "serve":
+		code = runServe(rest)
+	case "config":
+		code = runSubcommand("config", rest, map[string]func([]string) int{
+			"check": runConfigCheck,
+		})
+	case "migrate":
+		code = runMigrate(rest)
+	case "seed":
+		code = runSeed(rest)
+	case "user":
+		code = runSubcommand("user", rest, map[string]func([]string) int{
+			"create":   runUserCreate,
+			"set-role": runUserSetRole,
+		})
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "неизвестная команда %q\n\n%s", cmd, usage)
+		code = 2
 	}
-	defer postgres.Close(ctx)
 
-	redis, err := database.NewRedisConnection(ctx, cfg)
-	if err != nil {
-		slog.Error("Ошибка подключения к БД redis", "err", err)
-		os.Exit(1)
-	}
-	defer redis.Close()
+	os.Exit(code)
+}
 
-	if err := database.RunMigrations(ctx, cfg.Database.URL); err != nil {
-		slog.Error("Ошибка миграций", "err", err)
-		os.Exit(1)
+// runSubcommand — выбор подкоманды второго уровня (config check, user create, ...)
+func runSubcommand(name string, args []string, commands map[string]func([]string) int) int {
+	if len(args) > 0 {
+		if run, ok := commands[args[0]]; ok {
+			return run(args[1:])
+		}
 	}
 
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	fmt.Fprintf(os.Stderr, "handbooks %s: нужна подкоманда\n\n%s", name, usage)
+	return 2
+}
+
+// newFlagSet — флаги команды с общим -config
+func newFlagSet(name string) (*flag.FlagSet, *string) {
+	fs := flag.NewFlagSet("handbooks "+name, flag.ContinueOnError)
+	path := fs.String("config", configPath, "путь к config.toml")
+	return fs, path
+}
 
-	serverErr := make(chan error, 1)
-	go func() {
-		serverErr <- handlers.NewServer(postgres, redis, cfg).Run()
-	}()
+// loadConfig — загружает конфиг и пересоздаёт логгер по его настройкам.
+// До загрузки конфига пишем в dev-формате.
+func loadConfig(ctx context.Context, path string) (*config.Config, error) {
+	if err := logger.Setup(logger.Options{Format: logger.FormatDev, Level: slog.LevelInfo}); err != nil {
+		return nil, err
+	}
 
-	select {
-	case sig := <-sigChan:
-		slog.Info("Получен сигнал завершения", "signal", sig.String())
-	case err := <-serverErr:
-		slog.Error("Сервер упал", "error", err)
-		os.Exit(1)
+	cfg, err := config.NewConfig(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось загрузить конфигурацию: %w", err)
 	}
 
-	cancel()
+	if err := logger.Setup(logger.Options{
+		Format:     cfg.Handbooks.LogFormat,
+		Level:      cfg.Handbooks.LogLevel,
+		RedactKeys: cfg.Handbooks.RedactKeys,
+	}); err != nil {
+		return nil, fmt.Errorf("не удалось настроить логирование: %w", err)
+	}
 
-	slog.Info("Приложение остановлено")
+	return cfg, nil
 }
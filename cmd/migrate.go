@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"handbooks/internal/database"
+	"log/slog"
+	"os"
+	"slices"
+)
+
+var migrateCommands = []string{"up", "down", "status", "redo"}
+
+// runMigrate — `handbooks migrate [-config path] up|down|status|redo`
+func runMigrate(args []string) int {
+	fs, path := newFlagSet("migrate")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Использование: handbooks migrate [-config path] up|down|status|redo")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	command := fs.Arg(0)
+	if !slices.Contains(migrateCommands, command) {
+		fs.Usage()
+		return 2
+	}
+
+	ctx := context.Background()
+
+	cfg, err := loadConfig(ctx, *path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	if err := database.Migrate(ctx, cfg.Database.URL, command, cfg.Database.MigrationLockTimeoutDur); err != nil {
+		slog.Error("Ошибка миграций", "command", command, "error", err)
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"handbooks/internal/database"
+	"handbooks/internal/seed"
+	"log/slog"
+	"os"
+)
+
+// runSeed — `handbooks seed -file fixtures/demo.yaml`: загрузка демо-курсов
+func runSeed(args []string) int {
+	fs, path := newFlagSet("seed")
+	file := fs.String("file", "fixtures/demo.yaml", "фикстура с курсами (.yaml, .yml или .json)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	ctx := context.Background()
+
+	cfg, err := loadConfig(ctx, *path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fixture, err := seed.Load(*file)
+	if err != nil {
+		slog.Error("Не удалось прочитать фикстуру", "file", *file, "error", err)
+		return 1
+	}
+
+	db, err := database.NewDatabase(ctx, cfg.Database.URL)
+	if err != nil {
+		return 1
+	}
+	defer db.Close()
+
+	stats, err := seed.Apply(ctx, db, fixture)
+	if err != nil {
+		slog.Error("Не удалось загрузить фикстуру", "file", *file, "error", err)
+		return 1
+	}
+
+	slog.Info("Фикстура загружена",
+		slog.String("file", *file),
+		slog.Int("courses", stats.Courses),
+		slog.Int("sections", stats.Sections),
+		slog.Int("lessons", stats.Lessons),
+		slog.Int("skipped", stats.Skipped),
+	)
+	return 0
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"handbooks/internal/config"
+	"handbooks/internal/database"
+	handlers "handbooks/internal/handler"
+	"handbooks/internal/metrics"
+	"handbooks/internal/tracing"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// runServe — `handbooks serve`: миграции, HTTP сервер и остановка по сигналу
+func runServe(args []string) int {
+	fs, path := newFlagSet("serve")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg, err := loadConfig(ctx, *path)
+	if err != nil {
+		slog.Error("Ошибка запуска", "error", err)
+		return 1
+	}
+
+	shutdownTracing, err := tracing.Setup(ctx, cfg)
+	if err != nil {
+		slog.Error("Не удалось настроить трассировку", "error", err)
+		return 1
+	}
+
+	postgres, err := database.NewDatabase(ctx, cfg.Database.URL)
+	if err != nil {
+		slog.Error("Ошибка подключения к БД postgres", "err", err)
+		return 1
+	}
+
+	// Метрики пула регистрируются только здесь: остальные команды открывают свой пул и /metrics не отдают
+	if err := prometheus.Register(metrics.NewPoolCollector(postgres)); err != nil {
+		slog.Warn("Не удалось зарегистрировать метрики пула postgres", "err", err)
+	}
+
+	redis, err := database.NewRedisConnection(ctx, cfg)
+	if err != nil {
+		slog.Error("Ошибка подключения к БД redis", "err", err)
+		postgres.Close()
+		return 1
+	}
+
+	if cfg.Database.SkipMigrations {
+		slog.Info("Автоматические миграции отключены (database.skipMigrations)")
+	} else if err := database.RunMigrations(ctx, cfg.Database.URL, cfg.Database.MigrationLockTimeoutDur); err != nil {
+		slog.Error("Ошибка миграций", "err", err)
+		redis.Close()
+		postgres.Close()
+		return 1
+	}
+
+	// Со старой схемой запросы новой версии упадут — лучше не стартовать вовсе
+	if err := database.CheckSchemaVersion(ctx, postgres); err != nil {
+		slog.Error("Отказ в запуске", "err", err)
+		redis.Close()
+		postgres.Close()
+		return 1
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+
+	server := handlers.NewServer(ctx, postgres, redis, cfg)
+
+	go func() {
+		for sig := range sigChan {
+			if sig == syscall.SIGHUP {
+				reloadConfig(ctx, server, *path)
+				continue
+			}
+
+			slog.Info("Получен сигнал завершения", "signal", sig.String())
+			cancel()
+			return
+		}
+	}()
+
+	// Run возвращается, когда HTTP-сервер и фоновые задачи остановлены
+	exitCode := 0
+	if err := server.Run(); err != nil {
+		slog.Error("Сервер остановлен с ошибкой", "error", err)
+		exitCode = 1
+	}
+
+	slog.Info("Закрытие соединений с Redis и Postgres...")
+	if err := redis.Close(); err != nil {
+		slog.Error("Ошибка закрытия redis", "err", err)
+	}
+	postgres.Close()
+
+	// Дописываем оставшиеся в буфере спаны
+	flushCtx, flushCancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeoutDur)
+	if err := shutdownTracing(flushCtx); err != nil {
+		slog.Error("Ошибка выгрузки трейсов", "err", err)
+	}
+	flushCancel()
+
+	slog.Info("Приложение остановлено")
+	return exitCode
+}
+
+// reloadConfig — перечитывает конфиг по SIGHUP и применяет то, что можно поменять на лету.
+// При ошибке в новом конфиге сервер продолжает работать со старым.
+func reloadConfig(ctx context.Context, server *handlers.Server, path string) {
+	slog.Info("Получен SIGHUP, перечитываем конфигурацию", slog.String("path", path))
+
+	next, err := config.NewConfig(ctx, path)
+	if err != nil {
+		slog.Error("Новая конфигурация невалидна, оставляем текущую", "error", err)
+		return
+	}
+
+	applied, skipped := server.Reload(next)
+	if len(applied) == 0 && len(skipped) == 0 {
+		slog.Info("Конфигурация не изменилась")
+		return
+	}
+	if len(applied) > 0 {
+		slog.Info("Изменения конфигурации применены", slog.Any("keys", applied))
+	}
+	if len(skipped) > 0 {
+		slog.Warn("Изменения требуют перезапуска и не применены", slog.Any("keys", skipped))
+	}
+}
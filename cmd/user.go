@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"handbooks/internal/database"
+	handlers "handbooks/internal/handler"
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// runUserCreate — `handbooks user create -email ... -name ... -role admin`.
+// Пароль берётся из -password или читается первой строкой из stdin,
+// чтобы не оставлять его в истории shell.
+func runUserCreate(args []string) int {
+	fs, path := newFlagSet("user create")
+	email := fs.String("email", "", "email пользователя (обязателен)")
+	name := fs.String("name", "", "полное имя")
+	role := fs.String("role", models.RoleStudent, "роль: student, instructor или admin")
+	password := fs.String("password", "", "пароль; если не задан, читается из stdin")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if *email == "" {
+		fmt.Fprintln(os.Stderr, "-email обязателен")
+		return 2
+	}
+	if !models.IsValidRole(*role) {
+		fmt.Fprintf(os.Stderr, "неизвестная роль %q\n", *role)
+		return 2
+	}
+	if *password == "" {
+		p, err := readPassword()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 2
+		}
+		*password = p
+	}
+
+	ctx := context.Background()
+
+	cfg, err := loadConfig(ctx, *path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	db, err := database.NewDatabase(ctx, cfg.Database.URL)
+	if err != nil {
+		return 1
+	}
+	defer db.Close()
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(*password), bcrypt.DefaultCost)
+	if err != nil {
+		slog.Error("Не удалось захешировать пароль", "error", err)
+		return 1
+	}
+
+	now := time.Now()
+	id := uuid.New()
+	user := models.User{
+		ID:           id,
+		Email:        *email,
+		PasswordHash: string(passwordHash),
+		Slug:         handlers.GenerateUserSlug(*name, id),
+		FullName:     *name,
+		Role:         *role,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := storage.Create(ctx, "users", user, db); err != nil {
+		slog.Error("Не удалось создать пользователя", "email", *email, "error", err)
+		return 1
+	}
+
+	slog.Info("Пользователь создан", slog.String("id", id.String()), slog.String("email", *email), slog.String("role", *role))
+	return 0
+}
+
+// runUserSetRole — `handbooks user set-role -email ... -role admin`
+func runUserSetRole(args []string) int {
+	fs, path := newFlagSet("user set-role")
+	email := fs.String("email", "", "email пользователя (обязателен)")
+	role := fs.String("role", "", "новая роль: student, instructor или admin")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if *email == "" || !models.IsValidRole(*role) {
+		fmt.Fprintln(os.Stderr, "нужны -email и -role (student, instructor или admin)")
+		return 2
+	}
+
+	ctx := context.Background()
+
+	cfg, err := loadConfig(ctx, *path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	db, err := database.NewDatabase(ctx, cfg.Database.URL)
+	if err != nil {
+		return 1
+	}
+	defer db.Close()
+
+	user, err := storage.GetOne[models.User](ctx, db, "users", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("email", *email))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		slog.Error("Пользователь не найден", "email", *email)
+		return 1
+	}
+	if err != nil {
+		slog.Error("Не удалось получить пользователя", "email", *email, "error", err)
+		return 1
+	}
+
+	if err := storage.UpdateFields(ctx, "users", map[string]any{
+		"role":       *role,
+		"updated_at": time.Now(),
+	}, db, func(ub *sqlbuilder.UpdateBuilder) {
+		ub.Where(ub.Equal("id", user.ID))
+	}); err != nil {
+		slog.Error("Не удалось сменить роль", "email", *email, "error", err)
+		return 1
+	}
+
+	slog.Info("Роль изменена", slog.String("email", *email), slog.String("from", user.Role), slog.String("to", *role))
+	return 0
+}
+
+// readPassword — первая строка stdin без перевода строки
+func readPassword() (string, error) {
+	fmt.Fprint(os.Stderr, "Пароль: ")
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", errors.New("пароль не задан: укажите -password или передайте его в stdin")
+	}
+
+	password := strings.TrimRight(line, "\r\n")
+	if password == "" {
+		return "", errors.New("пустой пароль")
+	}
+	return password, nil
+}
@@ -1,6 +1,6 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version 2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
 package api
 
 import (
@@ -22,9 +22,11 @@ const (
 
 // Defines values for CourseStatus.
 const (
-	CourseStatusArchived  CourseStatus = "archived"
-	CourseStatusDraft     CourseStatus = "draft"
-	CourseStatusPublished CourseStatus = "published"
+	Approved  CourseStatus = "approved"
+	Archived  CourseStatus = "archived"
+	Draft     CourseStatus = "draft"
+	InReview  CourseStatus = "in_review"
+	Published CourseStatus = "published"
 )
 
 // Defines values for CourseCreateLevel.
@@ -34,6 +36,16 @@ const (
 	CourseCreateLevelIntermediate CourseCreateLevel = "intermediate"
 )
 
+// Defines values for CourseStatusChangeAction.
+const (
+	Approve   CourseStatusChangeAction = "approve"
+	Archive   CourseStatusChangeAction = "archive"
+	Publish   CourseStatusChangeAction = "publish"
+	Reject    CourseStatusChangeAction = "reject"
+	Submit    CourseStatusChangeAction = "submit"
+	Unpublish CourseStatusChangeAction = "unpublish"
+)
+
 // Defines values for CourseUpdateLevel.
 const (
 	Advanced     CourseUpdateLevel = "advanced"
@@ -41,13 +53,6 @@ const (
 	Intermediate CourseUpdateLevel = "intermediate"
 )
 
-// Defines values for CourseUpdateStatus.
-const (
-	CourseUpdateStatusArchived  CourseUpdateStatus = "archived"
-	CourseUpdateStatusDraft     CourseUpdateStatus = "draft"
-	CourseUpdateStatusPublished CourseUpdateStatus = "published"
-)
-
 // Defines values for EnrollmentStatus.
 const (
 	Active    EnrollmentStatus = "active"
@@ -96,6 +101,12 @@ const (
 	Student    UserRole = "student"
 )
 
+// Defines values for AuthOAuthStartParamsIntent.
+const (
+	Login  AuthOAuthStartParamsIntent = "login"
+	Reauth AuthOAuthStartParamsIntent = "reauth"
+)
+
 // ApiResponse defines model for ApiResponse.
 type ApiResponse struct {
 	Data      *map[string]interface{} `json:"data"`
@@ -106,26 +117,46 @@ type ApiResponse struct {
 
 // Course defines model for Course.
 type Course struct {
-	CoverUrl    *string       `json:"coverUrl,omitempty"`
-	CreatedAt   *time.Time    `json:"createdAt,omitempty"`
-	Currency    *string       `json:"currency,omitempty"`
-	Description *string       `json:"description,omitempty"`
-	Id          *int64        `json:"id,omitempty"`
-	Level       *CourseLevel  `json:"level,omitempty"`
-	Price       *float32      `json:"price,omitempty"`
-	Slug        *string       `json:"slug,omitempty"`
-	Status      *CourseStatus `json:"status,omitempty"`
-	Subtitle    *string       `json:"subtitle,omitempty"`
-	Title       *string       `json:"title,omitempty"`
-	UpdatedAt   *time.Time    `json:"updatedAt,omitempty"`
+	CoverUrl    *string      `json:"coverUrl,omitempty"`
+	CreatedAt   *time.Time   `json:"createdAt,omitempty"`
+	Currency    *string      `json:"currency,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Id          *int64       `json:"id,omitempty"`
+	Level       *CourseLevel `json:"level,omitempty"`
+	Price       *float32     `json:"price,omitempty"`
+
+	// PublishAt Запланированная публикация одобренного курса
+	PublishAt *time.Time `json:"publishAt"`
+
+	// PublishedVersionId Опубликованная версия, которую видят ученики. Правки курса, разделов и уроков попадают к ученикам только после следующей публикации
+	PublishedVersionId *openapi_types.UUID `json:"publishedVersionId"`
+	Slug               *string             `json:"slug,omitempty"`
+
+	// SourceCourseId Курс, копией которого создан этот (см. POST /courses/{courseID}/clone)
+	SourceCourseId *openapi_types.UUID `json:"sourceCourseId"`
+
+	// Status Меняется только через переходы workflow публикации
+	Status    *CourseStatus `json:"status,omitempty"`
+	Subtitle  *string       `json:"subtitle,omitempty"`
+	Title     *string       `json:"title,omitempty"`
+	UpdatedAt *time.Time    `json:"updatedAt,omitempty"`
 }
 
 // CourseLevel defines model for Course.Level.
 type CourseLevel string
 
-// CourseStatus defines model for Course.Status.
+// CourseStatus Меняется только через переходы workflow публикации
 type CourseStatus string
 
+// CourseCloneRequest defines model for CourseCloneRequest.
+type CourseCloneRequest struct {
+	// CopyInstructors Скопировать преподавателей исходного курса
+	CopyInstructors *bool `json:"copyInstructors,omitempty"`
+
+	// Title Название копии; по умолчанию название исходного курса с пометкой «копия»
+	Title *string `json:"title,omitempty"`
+}
+
 // CourseCreate defines model for CourseCreate.
 type CourseCreate struct {
 	CoverUrl    *string            `json:"coverUrl,omitempty"`
@@ -133,38 +164,85 @@ type CourseCreate struct {
 	Description *string            `json:"description,omitempty"`
 	Level       *CourseCreateLevel `json:"level,omitempty"`
 	Price       *float32           `json:"price,omitempty"`
-	Slug        string             `json:"slug"`
-	Subtitle    *string            `json:"subtitle,omitempty"`
-	Title       string             `json:"title"`
+
+	// PublishAt Запланированная публикация (после одобрения)
+	PublishAt *time.Time `json:"publishAt"`
+	Slug      string     `json:"slug"`
+	Subtitle  *string    `json:"subtitle,omitempty"`
+	Title     string     `json:"title"`
 }
 
 // CourseCreateLevel defines model for CourseCreate.Level.
 type CourseCreateLevel string
 
+// CourseStatusChange defines model for CourseStatusChange.
+type CourseStatusChange struct {
+	Action     *CourseStatusChangeAction `json:"action,omitempty"`
+	ActorId    *openapi_types.UUID       `json:"actorId"`
+	Comment    *string                   `json:"comment,omitempty"`
+	CourseId   *openapi_types.UUID       `json:"courseId,omitempty"`
+	CreatedAt  *time.Time                `json:"createdAt,omitempty"`
+	FromStatus *string                   `json:"fromStatus,omitempty"`
+	Id         *openapi_types.UUID       `json:"id,omitempty"`
+	ToStatus   *string                   `json:"toStatus,omitempty"`
+}
+
+// CourseStatusChangeAction defines model for CourseStatusChange.Action.
+type CourseStatusChangeAction string
+
+// CourseTransitionRequest defines model for CourseTransitionRequest.
+type CourseTransitionRequest struct {
+	// Comment Комментарий к переходу; обязателен при отклонении
+	Comment *string `json:"comment,omitempty"`
+}
+
 // CourseUpdate defines model for CourseUpdate.
 type CourseUpdate struct {
+	CoverUrl    *string            `json:"coverUrl,omitempty"`
+	Currency    *string            `json:"currency,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Level       *CourseUpdateLevel `json:"level,omitempty"`
+	Price       *float32           `json:"price,omitempty"`
+
+	// PublishAt Запланированная публикация (после одобрения)
+	PublishAt *time.Time `json:"publishAt"`
+	Slug      *string    `json:"slug,omitempty"`
+	Subtitle  *string    `json:"subtitle,omitempty"`
+	Title     *string    `json:"title,omitempty"`
+}
+
+// CourseUpdateLevel defines model for CourseUpdate.Level.
+type CourseUpdateLevel string
+
+// CourseVersion defines model for CourseVersion.
+type CourseVersion struct {
+	CourseId    *openapi_types.UUID `json:"courseId,omitempty"`
 	CoverUrl    *string             `json:"coverUrl,omitempty"`
+	CreatedAt   *time.Time          `json:"createdAt,omitempty"`
 	Currency    *string             `json:"currency,omitempty"`
 	Description *string             `json:"description,omitempty"`
-	Level       *CourseUpdateLevel  `json:"level,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+	Level       *string             `json:"level,omitempty"`
+	Number      *int                `json:"number,omitempty"`
 	Price       *float32            `json:"price,omitempty"`
-	Slug        *string             `json:"slug,omitempty"`
-	Status      *CourseUpdateStatus `json:"status,omitempty"`
+	PublishedBy *openapi_types.UUID `json:"publishedBy"`
 	Subtitle    *string             `json:"subtitle,omitempty"`
 	Title       *string             `json:"title,omitempty"`
 }
 
-// CourseUpdateLevel defines model for CourseUpdate.Level.
-type CourseUpdateLevel string
-
-// CourseUpdateStatus defines model for CourseUpdate.Status.
-type CourseUpdateStatus string
+// CourseVersionDetails defines model for CourseVersionDetails.
+type CourseVersionDetails struct {
+	Lessons  *[]Lesson      `json:"lessons,omitempty"`
+	Sections *[]Section     `json:"sections,omitempty"`
+	Version  *CourseVersion `json:"version,omitempty"`
+}
 
 // Enrollment defines model for Enrollment.
 type Enrollment struct {
-	CompletedAt *time.Time `json:"completedAt"`
-	EnrolledAt  *time.Time `json:"enrolledAt,omitempty"`
-	Id          *int64     `json:"id,omitempty"`
+	CompletedAt *time.Time          `json:"completedAt"`
+	CourseId    *openapi_types.UUID `json:"courseId,omitempty"`
+	EnrolledAt  *time.Time          `json:"enrolledAt,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
 
 	// Progress 0-100
 	Progress *float32          `json:"progress,omitempty"`
@@ -183,19 +261,42 @@ type ErrorResponse struct {
 
 // Lesson defines model for Lesson.
 type Lesson struct {
-	// Content URL видео, текст, markdown, JSON для quiz и т.д.
-	Content     *string    `json:"content,omitempty"`
+	// Content URL видео, JSON для quiz и т.д. У уроков type=text — исходный Markdown (GFM: таблицы, списки задач; блоки кода; формулы $...$ и $$...$$)
+	Content *string `json:"content,omitempty"`
+
+	// ContentHtml Только для type=text: Markdown, отрисованный в HTML и очищенный от опасной разметки. Формулы отдаются TeX-исходником в span/div с классами math math-inline и math math-display
+	ContentHtml *string    `json:"contentHtml,omitempty"`
 	CreatedAt   *time.Time `json:"createdAt,omitempty"`
-	DurationSec *int       `json:"durationSec"`
-	Id          *int64     `json:"id,omitempty"`
-	IsPublished *bool      `json:"isPublished,omitempty"`
+
+	// DripAfterPrevious Открыть после прохождения предыдущего раздела
+	DripAfterPrevious *bool `json:"dripAfterPrevious,omitempty"`
+
+	// DripDays Открыть через N дней после записи на курс
+	DripDays *int `json:"dripDays"`
+
+	// DurationSec Для type=text без заданной длительности заполняется оценкой времени чтения
+	DurationSec *int   `json:"durationSec"`
+	Id          *int64 `json:"id,omitempty"`
+	IsPublished *bool  `json:"isPublished,omitempty"`
+
+	// Locked Урок закрыт правилами drip для текущего пользователя; содержимое не отдаётся
+	Locked *bool `json:"locked,omitempty"`
 
 	// Order Порядковый номер урока внутри раздела
-	Order     *int        `json:"order,omitempty"`
-	SectionId *int64      `json:"sectionId,omitempty"`
-	Title     *string     `json:"title,omitempty"`
-	Type      *LessonType `json:"type,omitempty"`
-	UpdatedAt *time.Time  `json:"updatedAt,omitempty"`
+	Order *int `json:"order,omitempty"`
+
+	// PublishAt Запланированная публикация урока; до этого момента урок скрыт из списков
+	PublishAt *time.Time `json:"publishAt"`
+	SectionId *int64     `json:"sectionId,omitempty"`
+	Title     *string    `json:"title,omitempty"`
+
+	// Toc Только для type=text — оглавление по заголовкам
+	Toc  *[]TocEntry `json:"toc,omitempty"`
+	Type *LessonType `json:"type,omitempty"`
+
+	// UnlocksAt Когда урок откроется; нет, если дата заранее неизвестна
+	UnlocksAt *time.Time `json:"unlocksAt"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
 // LessonType defines model for Lesson.Type.
@@ -203,35 +304,102 @@ type LessonType string
 
 // LessonCreate defines model for LessonCreate.
 type LessonCreate struct {
-	Content     *string          `json:"content,omitempty"`
-	DurationSec *int             `json:"durationSec,omitempty"`
-	IsPublished *bool            `json:"isPublished,omitempty"`
-	Order       int              `json:"order"`
-	Title       string           `json:"title"`
-	Type        LessonCreateType `json:"type"`
+	Content *string `json:"content,omitempty"`
+
+	// DripAfterPrevious Открыть после прохождения предыдущего раздела
+	DripAfterPrevious *bool `json:"dripAfterPrevious,omitempty"`
+
+	// DripDays Открыть через N дней после записи на курс
+	DripDays    *int  `json:"dripDays"`
+	DurationSec *int  `json:"durationSec,omitempty"`
+	IsPublished *bool `json:"isPublished,omitempty"`
+
+	// Order Позиция, начиная с 1; следующие элементы сдвигаются. Если не задана — в конец
+	Order *int `json:"order,omitempty"`
+
+	// PublishAt Запланированная публикация урока
+	PublishAt *time.Time       `json:"publishAt"`
+	Title     string           `json:"title"`
+	Type      LessonCreateType `json:"type"`
 }
 
 // LessonCreateType defines model for LessonCreate.Type.
 type LessonCreateType string
 
+// LessonRevision defines model for LessonRevision.
+type LessonRevision struct {
+	AuthorId  *openapi_types.UUID `json:"authorId"`
+	Content   *string             `json:"content,omitempty"`
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	LessonId  *openapi_types.UUID `json:"lessonId,omitempty"`
+	Number    *int                `json:"number,omitempty"`
+
+	// RestoredFrom Номер ревизии, из которой восстановлено содержимое
+	RestoredFrom *int `json:"restoredFrom"`
+}
+
+// LessonRevisionDiff defines model for LessonRevisionDiff.
+type LessonRevisionDiff struct {
+	// Added Число добавленных строк
+	Added *int `json:"added,omitempty"`
+
+	// Diff Изменения содержимого в формате unified diff
+	Diff *string `json:"diff,omitempty"`
+	From *int    `json:"from,omitempty"`
+
+	// Removed Число удалённых строк
+	Removed *int `json:"removed,omitempty"`
+	To      *int `json:"to,omitempty"`
+}
+
 // LessonUpdate defines model for LessonUpdate.
 type LessonUpdate struct {
-	Content     *string           `json:"content,omitempty"`
-	DurationSec *int              `json:"durationSec,omitempty"`
-	IsPublished *bool             `json:"isPublished,omitempty"`
-	Order       *int              `json:"order,omitempty"`
-	Title       *string           `json:"title,omitempty"`
-	Type        *LessonUpdateType `json:"type,omitempty"`
+	Content *string `json:"content,omitempty"`
+
+	// DripAfterPrevious Открыть после прохождения предыдущего раздела
+	DripAfterPrevious *bool `json:"dripAfterPrevious,omitempty"`
+
+	// DripDays Открыть через N дней после записи на курс
+	DripDays    *int  `json:"dripDays"`
+	DurationSec *int  `json:"durationSec,omitempty"`
+	IsPublished *bool `json:"isPublished,omitempty"`
+
+	// PublishAt Запланированная публикация урока
+	PublishAt *time.Time        `json:"publishAt"`
+	Title     *string           `json:"title,omitempty"`
+	Type      *LessonUpdateType `json:"type,omitempty"`
 }
 
 // LessonUpdateType defines model for LessonUpdate.Type.
 type LessonUpdateType string
 
+// MfaVerifyRequest defines model for MfaVerifyRequest.
+type MfaVerifyRequest struct {
+	// ChallengeToken Токен, выданный /auth/login, когда требуется второй фактор
+	ChallengeToken string `json:"challengeToken"`
+
+	// Code Код из приложения-аутентификатора или одноразовый код восстановления
+	Code string `json:"code"`
+}
+
+// ReorderRequest defines model for ReorderRequest.
+type ReorderRequest struct {
+	// Ids Идентификаторы в новом порядке, первый получает order = 1
+	Ids []openapi_types.UUID `json:"ids"`
+}
+
 // Section defines model for Section.
 type Section struct {
 	CourseId  *int64     `json:"courseId,omitempty"`
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
+	// DripAfterPrevious Открыть после прохождения предыдущего раздела
+	DripAfterPrevious *bool `json:"dripAfterPrevious,omitempty"`
+
+	// DripDays Открыть через N дней после записи на курс
+	DripDays *int `json:"dripDays"`
+
 	// EstimatedTime minutes
 	EstimatedTime *int       `json:"estimatedTime,omitempty"`
 	Id            *int64     `json:"id,omitempty"`
@@ -243,21 +411,43 @@ type Section struct {
 
 // SectionCreate defines model for SectionCreate.
 type SectionCreate struct {
+	// DripAfterPrevious Открыть после прохождения предыдущего раздела
+	DripAfterPrevious *bool `json:"dripAfterPrevious,omitempty"`
+
+	// DripDays Открыть через N дней после записи на курс
+	DripDays *int `json:"dripDays"`
+
 	// EstimatedTime minutes
-	EstimatedTime *int   `json:"estimatedTime,omitempty"`
-	IsFreePreview *bool  `json:"isFreePreview,omitempty"`
-	Order         int    `json:"order"`
-	Title         string `json:"title"`
+	EstimatedTime *int  `json:"estimatedTime,omitempty"`
+	IsFreePreview *bool `json:"isFreePreview,omitempty"`
+
+	// Order Позиция, начиная с 1; следующие элементы сдвигаются. Если не задана — в конец
+	Order *int   `json:"order,omitempty"`
+	Title string `json:"title"`
 }
 
 // SectionUpdate defines model for SectionUpdate.
 type SectionUpdate struct {
+	// DripAfterPrevious Открыть после прохождения предыдущего раздела
+	DripAfterPrevious *bool `json:"dripAfterPrevious,omitempty"`
+
+	// DripDays Открыть через N дней после записи на курс
+	DripDays      *int    `json:"dripDays"`
 	EstimatedTime *int    `json:"estimatedTime,omitempty"`
 	IsFreePreview *bool   `json:"isFreePreview,omitempty"`
-	Order         *int    `json:"order,omitempty"`
 	Title         *string `json:"title,omitempty"`
 }
 
+// TocEntry defines model for TocEntry.
+type TocEntry struct {
+	// Id id заголовка в contentHtml, для ссылок на раздел урока
+	Id *string `json:"id,omitempty"`
+
+	// Level Уровень заголовка, 1–6
+	Level *int    `json:"level,omitempty"`
+	Text  *string `json:"text,omitempty"`
+}
+
 // TokenRefreshRequest defines model for TokenRefreshRequest.
 type TokenRefreshRequest struct {
 	// RefreshToken Refresh токен, полученный при логине
@@ -276,6 +466,12 @@ type TokenResponse struct {
 	RefreshToken string `json:"refreshToken"`
 }
 
+// TotpCodeRequest defines model for TotpCodeRequest.
+type TotpCodeRequest struct {
+	// Code Код из приложения-аутентификатора или одноразовый код восстановления
+	Code string `json:"code"`
+}
+
 // User defines model for User.
 type User struct {
 	AvatarUrl *string              `json:"avatarUrl"`
@@ -296,10 +492,16 @@ type UserCreate struct {
 	Password string              `json:"password"`
 }
 
-// UserDeleteRequest defines model for UserDeleteRequest.
+// UserDeleteRequest Подтверждение удаления — достаточно одного из полей
 type UserDeleteRequest struct {
-	// Password Текущий пароль для подтверждения удаления
-	Password string `json:"password"`
+	// Code Код из приложения-аутентификатора или код восстановления, если включена 2FA
+	Code *string `json:"code,omitempty"`
+
+	// Password Текущий пароль
+	Password *string `json:"password,omitempty"`
+
+	// ReauthToken Токен повторного входа через внешнего провайдера (GET /auth/oauth/{provider}?intent=reauth)
+	ReauthToken *string `json:"reauthToken,omitempty"`
 }
 
 // UserUpdate defines model for UserUpdate.
@@ -320,6 +522,21 @@ type AuthLoginUserJSONBody struct {
 	Password string `json:"password"`
 }
 
+// AuthOAuthStartParams defines parameters for AuthOAuthStart.
+type AuthOAuthStartParams struct {
+	// Intent reauth — повторный вход уже привязанной личностью для подтверждения удаления аккаунта
+	Intent *AuthOAuthStartParamsIntent `form:"intent,omitempty" json:"intent,omitempty"`
+}
+
+// AuthOAuthStartParamsIntent defines parameters for AuthOAuthStart.
+type AuthOAuthStartParamsIntent string
+
+// AuthOAuthCallbackParams defines parameters for AuthOAuthCallback.
+type AuthOAuthCallbackParams struct {
+	Code  string `form:"code" json:"code"`
+	State string `form:"state" json:"state"`
+}
+
 // GetCoursesParams defines parameters for GetCourses.
 type GetCoursesParams struct {
 	Page     *int    `form:"page,omitempty" json:"page,omitempty"`
@@ -331,15 +548,21 @@ type GetCoursesParams struct {
 	Search *string `form:"search,omitempty" json:"search,omitempty"`
 }
 
+// EnrollCourseJSONBody defines parameters for EnrollCourse.
+type EnrollCourseJSONBody struct {
+	PromoCode *string `json:"promoCode,omitempty"`
+}
+
 // GetLessonsParams defines parameters for GetLessons.
 type GetLessonsParams struct {
-	// PublishedOnly Показывать только опубликованные уроки (для студентов)
+	// PublishedOnly Показывать только опубликованные уроки; false учитывается только для команды курса и админов
 	PublishedOnly *bool `form:"publishedOnly,omitempty" json:"publishedOnly,omitempty"`
 }
 
-// EnrollCourseJSONBody defines parameters for EnrollCourse.
-type EnrollCourseJSONBody struct {
-	PromoCode *string `json:"promoCode,omitempty"`
+// DiffLessonRevisionsParams defines parameters for DiffLessonRevisions.
+type DiffLessonRevisionsParams struct {
+	From int `form:"from" json:"from"`
+	To   int `form:"to" json:"to"`
 }
 
 // UpdateLessonProgressJSONBody defines parameters for UpdateLessonProgress.
@@ -349,6 +572,9 @@ type UpdateLessonProgressJSONBody struct {
 	Percent        *float32 `json:"percent,omitempty"`
 }
 
+// AuthVerifyMfaJSONRequestBody defines body for AuthVerifyMfa for application/json ContentType.
+type AuthVerifyMfaJSONRequestBody = MfaVerifyRequest
+
 // AuthLoginUserJSONRequestBody defines body for AuthLoginUser for application/json ContentType.
 type AuthLoginUserJSONRequestBody AuthLoginUserJSONBody
 
@@ -364,9 +590,30 @@ type CreateCourseJSONRequestBody = CourseCreate
 // UpdateCourseJSONRequestBody defines body for UpdateCourse for application/json ContentType.
 type UpdateCourseJSONRequestBody = CourseUpdate
 
+// ApproveCourseJSONRequestBody defines body for ApproveCourse for application/json ContentType.
+type ApproveCourseJSONRequestBody = CourseTransitionRequest
+
+// ArchiveCourseJSONRequestBody defines body for ArchiveCourse for application/json ContentType.
+type ArchiveCourseJSONRequestBody = CourseTransitionRequest
+
+// CloneCourseJSONRequestBody defines body for CloneCourse for application/json ContentType.
+type CloneCourseJSONRequestBody = CourseCloneRequest
+
+// EnrollCourseJSONRequestBody defines body for EnrollCourse for application/json ContentType.
+type EnrollCourseJSONRequestBody EnrollCourseJSONBody
+
+// PublishCourseJSONRequestBody defines body for PublishCourse for application/json ContentType.
+type PublishCourseJSONRequestBody = CourseTransitionRequest
+
+// RejectCourseJSONRequestBody defines body for RejectCourse for application/json ContentType.
+type RejectCourseJSONRequestBody = CourseTransitionRequest
+
 // CreateSectionJSONRequestBody defines body for CreateSection for application/json ContentType.
 type CreateSectionJSONRequestBody = SectionCreate
 
+// ReorderSectionsJSONRequestBody defines body for ReorderSections for application/json ContentType.
+type ReorderSectionsJSONRequestBody = ReorderRequest
+
 // UpdateSectionJSONRequestBody defines body for UpdateSection for application/json ContentType.
 type UpdateSectionJSONRequestBody = SectionUpdate
 
@@ -376,8 +623,11 @@ type CreateLessonJSONRequestBody = LessonCreate
 // UpdateLessonJSONRequestBody defines body for UpdateLesson for application/json ContentType.
 type UpdateLessonJSONRequestBody = LessonUpdate
 
-// EnrollCourseJSONRequestBody defines body for EnrollCourse for application/json ContentType.
-type EnrollCourseJSONRequestBody EnrollCourseJSONBody
+// SubmitCourseJSONRequestBody defines body for SubmitCourse for application/json ContentType.
+type SubmitCourseJSONRequestBody = CourseTransitionRequest
+
+// UnpublishCourseJSONRequestBody defines body for UnpublishCourse for application/json ContentType.
+type UnpublishCourseJSONRequestBody = CourseTransitionRequest
 
 // DeleteCurrentUserJSONRequestBody defines body for DeleteCurrentUser for application/json ContentType.
 type DeleteCurrentUserJSONRequestBody = UserDeleteRequest
@@ -385,14 +635,32 @@ type DeleteCurrentUserJSONRequestBody = UserDeleteRequest
 // UpdateCurrentUserJSONRequestBody defines body for UpdateCurrentUser for application/json ContentType.
 type UpdateCurrentUserJSONRequestBody = UserUpdate
 
+// DisableTotpJSONRequestBody defines body for DisableTotp for application/json ContentType.
+type DisableTotpJSONRequestBody = TotpCodeRequest
+
+// VerifyTotpJSONRequestBody defines body for VerifyTotp for application/json ContentType.
+type VerifyTotpJSONRequestBody = TotpCodeRequest
+
 // UpdateLessonProgressJSONRequestBody defines body for UpdateLessonProgress for application/json ContentType.
 type UpdateLessonProgressJSONRequestBody UpdateLessonProgressJSONBody
 
+// ReorderLessonsJSONRequestBody defines body for ReorderLessons for application/json ContentType.
+type ReorderLessonsJSONRequestBody = ReorderRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Второй шаг входа — проверка TOTP или кода восстановления, выдача токенов
+	// (POST /auth/2fa)
+	AuthVerifyMfa(w http.ResponseWriter, r *http.Request)
 	// Авторизация пользователя
 	// (POST /auth/login)
 	AuthLoginUser(w http.ResponseWriter, r *http.Request)
+	// Начать вход через внешнего провайдера (Google, GitHub, OIDC)
+	// (GET /auth/oauth/{provider})
+	AuthOAuthStart(w http.ResponseWriter, r *http.Request, provider string, params AuthOAuthStartParams)
+	// Завершение входа через внешнего провайдера, выдача токенов
+	// (GET /auth/oauth/{provider}/callback)
+	AuthOAuthCallback(w http.ResponseWriter, r *http.Request, provider string, params AuthOAuthCallbackParams)
 	// Обновление access-токена с помощью refresh-токена (token rotation)
 	// (POST /auth/refresh)
 	AuthRefreshToken(w http.ResponseWriter, r *http.Request)
@@ -405,6 +673,15 @@ type ServerInterface interface {
 	// Создание нового курса (только для преподавателей и админов)
 	// (POST /courses)
 	CreateCourse(w http.ResponseWriter, r *http.Request)
+	// Получить курс по slug
+	// (GET /courses/by-slug/{courseSlug})
+	GetCourseBySlug(w http.ResponseWriter, r *http.Request, courseSlug string)
+	// Получить раздел по slug курса и раздела
+	// (GET /courses/by-slug/{courseSlug}/sections/{sectionSlug})
+	GetSectionBySlug(w http.ResponseWriter, r *http.Request, courseSlug string, sectionSlug string)
+	// Получить урок по slug курса, раздела и урока
+	// (GET /courses/by-slug/{courseSlug}/sections/{sectionSlug}/lessons/{lessonSlug})
+	GetLessonBySlug(w http.ResponseWriter, r *http.Request, courseSlug string, sectionSlug string, lessonSlug string)
 	// Удалить курс (только для админов или владельца)
 	// (DELETE /courses/{courseID})
 	DeleteCourse(w http.ResponseWriter, r *http.Request, courseID string)
@@ -414,12 +691,36 @@ type ServerInterface interface {
 	// Частично обновить курс (для преподавателей и админов)
 	// (PATCH /courses/{courseID})
 	UpdateCourse(w http.ResponseWriter, r *http.Request, courseID string)
+	// Одобрить курс (только админ)
+	// (POST /courses/{courseID}/approve)
+	ApproveCourse(w http.ResponseWriter, r *http.Request, courseID string)
+	// Архивировать курс
+	// (POST /courses/{courseID}/archive)
+	ArchiveCourse(w http.ResponseWriter, r *http.Request, courseID string)
+	// Создать копию курса со всеми разделами и уроками (черновик)
+	// (POST /courses/{courseID}/clone)
+	CloneCourse(w http.ResponseWriter, r *http.Request, courseID string)
+	// Записаться на курс / купить курс
+	// (POST /courses/{courseID}/enroll)
+	EnrollCourse(w http.ResponseWriter, r *http.Request, courseID string)
+	// История переходов статуса курса
+	// (GET /courses/{courseID}/history)
+	GetCourseHistory(w http.ResponseWriter, r *http.Request, courseID string)
+	// Опубликовать одобренный курс
+	// (POST /courses/{courseID}/publish)
+	PublishCourse(w http.ResponseWriter, r *http.Request, courseID string)
+	// Отклонить курс с комментарием (только админ)
+	// (POST /courses/{courseID}/reject)
+	RejectCourse(w http.ResponseWriter, r *http.Request, courseID string)
 	// Получить все разделы курса
 	// (GET /courses/{courseID}/sections)
 	GetSections(w http.ResponseWriter, r *http.Request, courseID string)
 	// Создать новый раздел в курсе (для преподавателей/админов)
 	// (POST /courses/{courseID}/sections)
 	CreateSection(w http.ResponseWriter, r *http.Request, courseID string)
+	// Задать порядок всех разделов курса одним запросом
+	// (PUT /courses/{courseID}/sections/order)
+	ReorderSections(w http.ResponseWriter, r *http.Request, courseID string)
 	// Удалить раздел (для преподавателей/админов)
 	// (DELETE /courses/{courseID}/sections/{sectionID})
 	DeleteSection(w http.ResponseWriter, r *http.Request, courseID string, sectionID string)
@@ -444,10 +745,28 @@ type ServerInterface interface {
 	// Обновить урок (для преподавателей/админов)
 	// (PATCH /courses/{courseID}/sections/{sectionID}/lessons/{lessonID})
 	UpdateLesson(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string)
-	// Записаться на курс / купить курс
-	// (POST /courses/{courseId}/enroll)
-	EnrollCourse(w http.ResponseWriter, r *http.Request, courseId int)
-	// Удалить аккаунт текущего пользователя (с подтверждением паролем)
+	// История ревизий содержимого урока (для команды курса)
+	// (GET /courses/{courseID}/sections/{sectionID}/lessons/{lessonID}/revisions)
+	GetLessonRevisions(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string)
+	// Разница содержимого между двумя ревизиями урока
+	// (GET /courses/{courseID}/sections/{sectionID}/lessons/{lessonID}/revisions/diff)
+	DiffLessonRevisions(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string, params DiffLessonRevisionsParams)
+	// Восстановить содержимое урока из ревизии
+	// (POST /courses/{courseID}/sections/{sectionID}/lessons/{lessonID}/revisions/{revision}/restore)
+	RestoreLessonRevision(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string, revision int)
+	// Отправить курс на ревью (владелец, преподаватель курса или админ)
+	// (POST /courses/{courseID}/submit)
+	SubmitCourse(w http.ResponseWriter, r *http.Request, courseID string)
+	// Снять курс с публикации (возвращается в черновик)
+	// (POST /courses/{courseID}/unpublish)
+	UnpublishCourse(w http.ResponseWriter, r *http.Request, courseID string)
+	// Опубликованные версии курса (для команды курса)
+	// (GET /courses/{courseID}/versions)
+	GetCourseVersions(w http.ResponseWriter, r *http.Request, courseID string)
+	// Снимок курса, разделов и уроков в опубликованной версии (для команды курса)
+	// (GET /courses/{courseID}/versions/{version})
+	GetCourseVersion(w http.ResponseWriter, r *http.Request, courseID string, version int)
+	// Удалить аккаунт текущего пользователя (с подтверждением паролем, кодом 2FA или повторным входом)
 	// (DELETE /me)
 	DeleteCurrentUser(w http.ResponseWriter, r *http.Request)
 	// Получить информацию о текущем пользователе
@@ -456,12 +775,27 @@ type ServerInterface interface {
 	// Обновить данные текущего пользователя (fullName, avatar, пароль)
 	// (PATCH /me)
 	UpdateCurrentUser(w http.ResponseWriter, r *http.Request)
+	// Отключить TOTP (подтверждается кодом)
+	// (DELETE /me/2fa/totp)
+	DisableTotp(w http.ResponseWriter, r *http.Request)
+	// Начать подключение TOTP — выдаёт секрет и provisioning URI для QR-кода
+	// (POST /me/2fa/totp)
+	EnrollTotp(w http.ResponseWriter, r *http.Request)
+	// Подтвердить подключение TOTP первым кодом, получить коды восстановления
+	// (POST /me/2fa/totp/verify)
+	VerifyTotp(w http.ResponseWriter, r *http.Request)
 	// Обновить прогресс урока
 	// (PATCH /me/courses/{courseID}/lessons/{lessonID}/progress)
 	UpdateLessonProgress(w http.ResponseWriter, r *http.Request, courseID string, lessonID string)
+	// Выгрузить все данные текущего пользователя в JSON-архив (GDPR)
+	// (GET /me/export)
+	ExportCurrentUser(w http.ResponseWriter, r *http.Request)
 	// Прогресс пользователя по всем курсам
 	// (GET /me/progress)
 	GetUserProgress(w http.ResponseWriter, r *http.Request)
+	// Задать порядок уроков раздела; уроки из других разделов того же курса переносятся в этот раздел
+	// (PUT /sections/{sectionID}/lessons/order)
+	ReorderLessons(w http.ResponseWriter, r *http.Request, sectionID string)
 	// Удалить пользователя (только для админов)
 	// (DELETE /users/{userId})
 	DeleteUserById(w http.ResponseWriter, r *http.Request, userId string)
@@ -471,12 +805,30 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
+// Второй шаг входа — проверка TOTP или кода восстановления, выдача токенов
+// (POST /auth/2fa)
+func (_ Unimplemented) AuthVerifyMfa(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Авторизация пользователя
 // (POST /auth/login)
 func (_ Unimplemented) AuthLoginUser(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Начать вход через внешнего провайдера (Google, GitHub, OIDC)
+// (GET /auth/oauth/{provider})
+func (_ Unimplemented) AuthOAuthStart(w http.ResponseWriter, r *http.Request, provider string, params AuthOAuthStartParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Завершение входа через внешнего провайдера, выдача токенов
+// (GET /auth/oauth/{provider}/callback)
+func (_ Unimplemented) AuthOAuthCallback(w http.ResponseWriter, r *http.Request, provider string, params AuthOAuthCallbackParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Обновление access-токена с помощью refresh-токена (token rotation)
 // (POST /auth/refresh)
 func (_ Unimplemented) AuthRefreshToken(w http.ResponseWriter, r *http.Request) {
@@ -501,6 +853,24 @@ func (_ Unimplemented) CreateCourse(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получить курс по slug
+// (GET /courses/by-slug/{courseSlug})
+func (_ Unimplemented) GetCourseBySlug(w http.ResponseWriter, r *http.Request, courseSlug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить раздел по slug курса и раздела
+// (GET /courses/by-slug/{courseSlug}/sections/{sectionSlug})
+func (_ Unimplemented) GetSectionBySlug(w http.ResponseWriter, r *http.Request, courseSlug string, sectionSlug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить урок по slug курса, раздела и урока
+// (GET /courses/by-slug/{courseSlug}/sections/{sectionSlug}/lessons/{lessonSlug})
+func (_ Unimplemented) GetLessonBySlug(w http.ResponseWriter, r *http.Request, courseSlug string, sectionSlug string, lessonSlug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Удалить курс (только для админов или владельца)
 // (DELETE /courses/{courseID})
 func (_ Unimplemented) DeleteCourse(w http.ResponseWriter, r *http.Request, courseID string) {
@@ -519,6 +889,48 @@ func (_ Unimplemented) UpdateCourse(w http.ResponseWriter, r *http.Request, cour
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Одобрить курс (только админ)
+// (POST /courses/{courseID}/approve)
+func (_ Unimplemented) ApproveCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Архивировать курс
+// (POST /courses/{courseID}/archive)
+func (_ Unimplemented) ArchiveCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать копию курса со всеми разделами и уроками (черновик)
+// (POST /courses/{courseID}/clone)
+func (_ Unimplemented) CloneCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Записаться на курс / купить курс
+// (POST /courses/{courseID}/enroll)
+func (_ Unimplemented) EnrollCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// История переходов статуса курса
+// (GET /courses/{courseID}/history)
+func (_ Unimplemented) GetCourseHistory(w http.ResponseWriter, r *http.Request, courseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Опубликовать одобренный курс
+// (POST /courses/{courseID}/publish)
+func (_ Unimplemented) PublishCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отклонить курс с комментарием (только админ)
+// (POST /courses/{courseID}/reject)
+func (_ Unimplemented) RejectCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получить все разделы курса
 // (GET /courses/{courseID}/sections)
 func (_ Unimplemented) GetSections(w http.ResponseWriter, r *http.Request, courseID string) {
@@ -531,6 +943,12 @@ func (_ Unimplemented) CreateSection(w http.ResponseWriter, r *http.Request, cou
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Задать порядок всех разделов курса одним запросом
+// (PUT /courses/{courseID}/sections/order)
+func (_ Unimplemented) ReorderSections(w http.ResponseWriter, r *http.Request, courseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Удалить раздел (для преподавателей/админов)
 // (DELETE /courses/{courseID}/sections/{sectionID})
 func (_ Unimplemented) DeleteSection(w http.ResponseWriter, r *http.Request, courseID string, sectionID string) {
@@ -579,13 +997,49 @@ func (_ Unimplemented) UpdateLesson(w http.ResponseWriter, r *http.Request, cour
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Записаться на курс / купить курс
-// (POST /courses/{courseId}/enroll)
-func (_ Unimplemented) EnrollCourse(w http.ResponseWriter, r *http.Request, courseId int) {
+// История ревизий содержимого урока (для команды курса)
+// (GET /courses/{courseID}/sections/{sectionID}/lessons/{lessonID}/revisions)
+func (_ Unimplemented) GetLessonRevisions(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Разница содержимого между двумя ревизиями урока
+// (GET /courses/{courseID}/sections/{sectionID}/lessons/{lessonID}/revisions/diff)
+func (_ Unimplemented) DiffLessonRevisions(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string, params DiffLessonRevisionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановить содержимое урока из ревизии
+// (POST /courses/{courseID}/sections/{sectionID}/lessons/{lessonID}/revisions/{revision}/restore)
+func (_ Unimplemented) RestoreLessonRevision(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string, revision int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отправить курс на ревью (владелец, преподаватель курса или админ)
+// (POST /courses/{courseID}/submit)
+func (_ Unimplemented) SubmitCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Снять курс с публикации (возвращается в черновик)
+// (POST /courses/{courseID}/unpublish)
+func (_ Unimplemented) UnpublishCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Опубликованные версии курса (для команды курса)
+// (GET /courses/{courseID}/versions)
+func (_ Unimplemented) GetCourseVersions(w http.ResponseWriter, r *http.Request, courseID string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Удалить аккаунт текущего пользователя (с подтверждением паролем)
+// Снимок курса, разделов и уроков в опубликованной версии (для команды курса)
+// (GET /courses/{courseID}/versions/{version})
+func (_ Unimplemented) GetCourseVersion(w http.ResponseWriter, r *http.Request, courseID string, version int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить аккаунт текущего пользователя (с подтверждением паролем, кодом 2FA или повторным входом)
 // (DELETE /me)
 func (_ Unimplemented) DeleteCurrentUser(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -603,18 +1057,48 @@ func (_ Unimplemented) UpdateCurrentUser(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Отключить TOTP (подтверждается кодом)
+// (DELETE /me/2fa/totp)
+func (_ Unimplemented) DisableTotp(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Начать подключение TOTP — выдаёт секрет и provisioning URI для QR-кода
+// (POST /me/2fa/totp)
+func (_ Unimplemented) EnrollTotp(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Подтвердить подключение TOTP первым кодом, получить коды восстановления
+// (POST /me/2fa/totp/verify)
+func (_ Unimplemented) VerifyTotp(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Обновить прогресс урока
 // (PATCH /me/courses/{courseID}/lessons/{lessonID}/progress)
 func (_ Unimplemented) UpdateLessonProgress(w http.ResponseWriter, r *http.Request, courseID string, lessonID string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Выгрузить все данные текущего пользователя в JSON-архив (GDPR)
+// (GET /me/export)
+func (_ Unimplemented) ExportCurrentUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Прогресс пользователя по всем курсам
 // (GET /me/progress)
 func (_ Unimplemented) GetUserProgress(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Задать порядок уроков раздела; уроки из других разделов того же курса переносятся в этот раздел
+// (PUT /sections/{sectionID}/lessons/order)
+func (_ Unimplemented) ReorderLessons(w http.ResponseWriter, r *http.Request, sectionID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Удалить пользователя (только для админов)
 // (DELETE /users/{userId})
 func (_ Unimplemented) DeleteUserById(w http.ResponseWriter, r *http.Request, userId string) {
@@ -630,11 +1114,11 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// AuthLoginUser operation middleware
-func (siw *ServerInterfaceWrapper) AuthLoginUser(w http.ResponseWriter, r *http.Request) {
+// AuthVerifyMfa operation middleware
+func (siw *ServerInterfaceWrapper) AuthVerifyMfa(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthLoginUser(w, r)
+		siw.Handler.AuthVerifyMfa(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -644,11 +1128,11 @@ func (siw *ServerInterfaceWrapper) AuthLoginUser(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// AuthRefreshToken operation middleware
-func (siw *ServerInterfaceWrapper) AuthRefreshToken(w http.ResponseWriter, r *http.Request) {
+// AuthLoginUser operation middleware
+func (siw *ServerInterfaceWrapper) AuthLoginUser(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthRefreshToken(w, r)
+		siw.Handler.AuthLoginUser(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -658,11 +1142,33 @@ func (siw *ServerInterfaceWrapper) AuthRefreshToken(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// AuthRegisterUser operation middleware
-func (siw *ServerInterfaceWrapper) AuthRegisterUser(w http.ResponseWriter, r *http.Request) {
+// AuthOAuthStart operation middleware
+func (siw *ServerInterfaceWrapper) AuthOAuthStart(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuthOAuthStartParams
+
+	// ------------- Optional query parameter "intent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "intent", r.URL.Query(), &params.Intent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "intent", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthRegisterUser(w, r)
+		siw.Handler.AuthOAuthStart(w, r, provider, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -672,56 +1178,55 @@ func (siw *ServerInterfaceWrapper) AuthRegisterUser(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetCourses operation middleware
-func (siw *ServerInterfaceWrapper) GetCourses(w http.ResponseWriter, r *http.Request) {
+// AuthOAuthCallback operation middleware
+func (siw *ServerInterfaceWrapper) AuthOAuthCallback(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCoursesParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "provider" -------------
+	var provider string
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuthOAuthCallbackParams
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := r.URL.Query().Get("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code"})
 		return
 	}
 
-	// ------------- Optional query parameter "category" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	err = runtime.BindQueryParameter("form", true, true, "code", r.URL.Query(), &params.Code)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "level" -------------
+	// ------------- Required query parameter "state" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "level", r.URL.Query(), &params.Level)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
 		return
 	}
 
-	// ------------- Optional query parameter "search" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCourses(w, r, params)
+		siw.Handler.AuthOAuthCallback(w, r, provider, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -731,11 +1236,11 @@ func (siw *ServerInterfaceWrapper) GetCourses(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// CreateCourse operation middleware
-func (siw *ServerInterfaceWrapper) CreateCourse(w http.ResponseWriter, r *http.Request) {
+// AuthRefreshToken operation middleware
+func (siw *ServerInterfaceWrapper) AuthRefreshToken(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCourse(w, r)
+		siw.Handler.AuthRefreshToken(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -745,12 +1250,201 @@ func (siw *ServerInterfaceWrapper) CreateCourse(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteCourse operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCourse(w http.ResponseWriter, r *http.Request) {
+// AuthRegisterUser operation middleware
+func (siw *ServerInterfaceWrapper) AuthRegisterUser(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthRegisterUser(w, r)
+	}))
 
-	// ------------- Path parameter "courseID" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCourses operation middleware
+func (siw *ServerInterfaceWrapper) GetCourses(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCoursesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "level", r.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourses(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCourse operation middleware
+func (siw *ServerInterfaceWrapper) CreateCourse(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCourse(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCourseBySlug operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseBySlug(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseSlug" -------------
+	var courseSlug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseSlug", chi.URLParam(r, "courseSlug"), &courseSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseSlug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseBySlug(w, r, courseSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSectionBySlug operation middleware
+func (siw *ServerInterfaceWrapper) GetSectionBySlug(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseSlug" -------------
+	var courseSlug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseSlug", chi.URLParam(r, "courseSlug"), &courseSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseSlug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sectionSlug" -------------
+	var sectionSlug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sectionSlug", chi.URLParam(r, "sectionSlug"), &sectionSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sectionSlug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSectionBySlug(w, r, courseSlug, sectionSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLessonBySlug operation middleware
+func (siw *ServerInterfaceWrapper) GetLessonBySlug(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseSlug" -------------
+	var courseSlug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseSlug", chi.URLParam(r, "courseSlug"), &courseSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseSlug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sectionSlug" -------------
+	var sectionSlug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sectionSlug", chi.URLParam(r, "sectionSlug"), &sectionSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sectionSlug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "lessonSlug" -------------
+	var lessonSlug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonSlug", chi.URLParam(r, "lessonSlug"), &lessonSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonSlug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLessonBySlug(w, r, courseSlug, sectionSlug, lessonSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCourse operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
 	var courseID string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
@@ -820,6 +1514,181 @@ func (siw *ServerInterfaceWrapper) UpdateCourse(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
+// ApproveCourse operation middleware
+func (siw *ServerInterfaceWrapper) ApproveCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveCourse(w, r, courseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ArchiveCourse operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchiveCourse(w, r, courseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloneCourse operation middleware
+func (siw *ServerInterfaceWrapper) CloneCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloneCourse(w, r, courseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EnrollCourse operation middleware
+func (siw *ServerInterfaceWrapper) EnrollCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnrollCourse(w, r, courseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCourseHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseHistory(w, r, courseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PublishCourse operation middleware
+func (siw *ServerInterfaceWrapper) PublishCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishCourse(w, r, courseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectCourse operation middleware
+func (siw *ServerInterfaceWrapper) RejectCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectCourse(w, r, courseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetSections operation middleware
 func (siw *ServerInterfaceWrapper) GetSections(w http.ResponseWriter, r *http.Request) {
 
@@ -870,6 +1739,31 @@ func (siw *ServerInterfaceWrapper) CreateSection(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
+// ReorderSections operation middleware
+func (siw *ServerInterfaceWrapper) ReorderSections(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReorderSections(w, r, courseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // DeleteSection operation middleware
 func (siw *ServerInterfaceWrapper) DeleteSection(w http.ResponseWriter, r *http.Request) {
 
@@ -918,17 +1812,173 @@ func (siw *ServerInterfaceWrapper) GetSectionByID(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Path parameter "sectionID" -------------
-	var sectionID string
+	// ------------- Path parameter "sectionID" -------------
+	var sectionID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sectionID", chi.URLParam(r, "sectionID"), &sectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSectionByID(w, r, courseID, sectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateSection operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sectionID" -------------
+	var sectionID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sectionID", chi.URLParam(r, "sectionID"), &sectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSection(w, r, courseID, sectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLessons operation middleware
+func (siw *ServerInterfaceWrapper) GetLessons(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sectionID" -------------
+	var sectionID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sectionID", chi.URLParam(r, "sectionID"), &sectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sectionID", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLessonsParams
+
+	// ------------- Optional query parameter "publishedOnly" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "publishedOnly", r.URL.Query(), &params.PublishedOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "publishedOnly", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLessons(w, r, courseID, sectionID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateLesson operation middleware
+func (siw *ServerInterfaceWrapper) CreateLesson(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sectionID" -------------
+	var sectionID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sectionID", chi.URLParam(r, "sectionID"), &sectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLesson(w, r, courseID, sectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteLesson operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLesson(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sectionID" -------------
+	var sectionID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sectionID", chi.URLParam(r, "sectionID"), &sectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "lessonID" -------------
+	var lessonID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sectionID", chi.URLParam(r, "sectionID"), &sectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonID", chi.URLParam(r, "lessonID"), &lessonID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sectionID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSectionByID(w, r, courseID, sectionID)
+		siw.Handler.DeleteLesson(w, r, courseID, sectionID, lessonID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -938,8 +1988,8 @@ func (siw *ServerInterfaceWrapper) GetSectionByID(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateSection operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSection(w http.ResponseWriter, r *http.Request) {
+// GetLessonByID operation middleware
+func (siw *ServerInterfaceWrapper) GetLessonByID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -961,8 +2011,17 @@ func (siw *ServerInterfaceWrapper) UpdateSection(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// ------------- Path parameter "lessonID" -------------
+	var lessonID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonID", chi.URLParam(r, "lessonID"), &lessonID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonID", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSection(w, r, courseID, sectionID)
+		siw.Handler.GetLessonByID(w, r, courseID, sectionID, lessonID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -972,8 +2031,8 @@ func (siw *ServerInterfaceWrapper) UpdateSection(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetLessons operation middleware
-func (siw *ServerInterfaceWrapper) GetLessons(w http.ResponseWriter, r *http.Request) {
+// UpdateLesson operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLesson(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -995,19 +2054,17 @@ func (siw *ServerInterfaceWrapper) GetLessons(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLessonsParams
-
-	// ------------- Optional query parameter "publishedOnly" -------------
+	// ------------- Path parameter "lessonID" -------------
+	var lessonID string
 
-	err = runtime.BindQueryParameter("form", true, false, "publishedOnly", r.URL.Query(), &params.PublishedOnly)
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonID", chi.URLParam(r, "lessonID"), &lessonID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "publishedOnly", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLessons(w, r, courseID, sectionID, params)
+		siw.Handler.UpdateLesson(w, r, courseID, sectionID, lessonID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1017,8 +2074,8 @@ func (siw *ServerInterfaceWrapper) GetLessons(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// CreateLesson operation middleware
-func (siw *ServerInterfaceWrapper) CreateLesson(w http.ResponseWriter, r *http.Request) {
+// GetLessonRevisions operation middleware
+func (siw *ServerInterfaceWrapper) GetLessonRevisions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1040,8 +2097,17 @@ func (siw *ServerInterfaceWrapper) CreateLesson(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Path parameter "lessonID" -------------
+	var lessonID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonID", chi.URLParam(r, "lessonID"), &lessonID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonID", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLesson(w, r, courseID, sectionID)
+		siw.Handler.GetLessonRevisions(w, r, courseID, sectionID, lessonID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1051,8 +2117,8 @@ func (siw *ServerInterfaceWrapper) CreateLesson(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteLesson operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLesson(w http.ResponseWriter, r *http.Request) {
+// DiffLessonRevisions operation middleware
+func (siw *ServerInterfaceWrapper) DiffLessonRevisions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1083,8 +2149,41 @@ func (siw *ServerInterfaceWrapper) DeleteLesson(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffLessonRevisionsParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLesson(w, r, courseID, sectionID, lessonID)
+		siw.Handler.DiffLessonRevisions(w, r, courseID, sectionID, lessonID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1094,8 +2193,8 @@ func (siw *ServerInterfaceWrapper) DeleteLesson(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetLessonByID operation middleware
-func (siw *ServerInterfaceWrapper) GetLessonByID(w http.ResponseWriter, r *http.Request) {
+// RestoreLessonRevision operation middleware
+func (siw *ServerInterfaceWrapper) RestoreLessonRevision(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1126,8 +2225,17 @@ func (siw *ServerInterfaceWrapper) GetLessonByID(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// ------------- Path parameter "revision" -------------
+	var revision int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", chi.URLParam(r, "revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLessonByID(w, r, courseID, sectionID, lessonID)
+		siw.Handler.RestoreLessonRevision(w, r, courseID, sectionID, lessonID, revision)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1137,8 +2245,8 @@ func (siw *ServerInterfaceWrapper) GetLessonByID(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateLesson operation middleware
-func (siw *ServerInterfaceWrapper) UpdateLesson(w http.ResponseWriter, r *http.Request) {
+// SubmitCourse operation middleware
+func (siw *ServerInterfaceWrapper) SubmitCourse(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1151,26 +2259,58 @@ func (siw *ServerInterfaceWrapper) UpdateLesson(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Path parameter "sectionID" -------------
-	var sectionID string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitCourse(w, r, courseID)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sectionID", chi.URLParam(r, "sectionID"), &sectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnpublishCourse operation middleware
+func (siw *ServerInterfaceWrapper) UnpublishCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sectionID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "lessonID" -------------
-	var lessonID string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnpublishCourse(w, r, courseID)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "lessonID", chi.URLParam(r, "lessonID"), &lessonID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCourseVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseVersions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateLesson(w, r, courseID, sectionID, lessonID)
+		siw.Handler.GetCourseVersions(w, r, courseID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1180,22 +2320,31 @@ func (siw *ServerInterfaceWrapper) UpdateLesson(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// EnrollCourse operation middleware
-func (siw *ServerInterfaceWrapper) EnrollCourse(w http.ResponseWriter, r *http.Request) {
+// GetCourseVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseVersion(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "courseId" -------------
-	var courseId int
+	// ------------- Path parameter "courseID" -------------
+	var courseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseID", chi.URLParam(r, "courseID"), &courseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.EnrollCourse(w, r, courseId)
+		siw.Handler.GetCourseVersion(w, r, courseID, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1247,6 +2396,48 @@ func (siw *ServerInterfaceWrapper) UpdateCurrentUser(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
+// DisableTotp operation middleware
+func (siw *ServerInterfaceWrapper) DisableTotp(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisableTotp(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EnrollTotp operation middleware
+func (siw *ServerInterfaceWrapper) EnrollTotp(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnrollTotp(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyTotp operation middleware
+func (siw *ServerInterfaceWrapper) VerifyTotp(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyTotp(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // UpdateLessonProgress operation middleware
 func (siw *ServerInterfaceWrapper) UpdateLessonProgress(w http.ResponseWriter, r *http.Request) {
 
@@ -1281,6 +2472,20 @@ func (siw *ServerInterfaceWrapper) UpdateLessonProgress(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// ExportCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) ExportCurrentUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportCurrentUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetUserProgress operation middleware
 func (siw *ServerInterfaceWrapper) GetUserProgress(w http.ResponseWriter, r *http.Request) {
 
@@ -1295,6 +2500,31 @@ func (siw *ServerInterfaceWrapper) GetUserProgress(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// ReorderLessons operation middleware
+func (siw *ServerInterfaceWrapper) ReorderLessons(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sectionID" -------------
+	var sectionID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sectionID", chi.URLParam(r, "sectionID"), &sectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReorderLessons(w, r, sectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // DeleteUserById operation middleware
 func (siw *ServerInterfaceWrapper) DeleteUserById(w http.ResponseWriter, r *http.Request) {
 
@@ -1433,9 +2663,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/2fa", wrapper.AuthVerifyMfa)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/login", wrapper.AuthLoginUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/oauth/{provider}", wrapper.AuthOAuthStart)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/oauth/{provider}/callback", wrapper.AuthOAuthCallback)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/refresh", wrapper.AuthRefreshToken)
 	})
@@ -1448,6 +2687,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/courses", wrapper.CreateCourse)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/by-slug/{courseSlug}", wrapper.GetCourseBySlug)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/by-slug/{courseSlug}/sections/{sectionSlug}", wrapper.GetSectionBySlug)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/by-slug/{courseSlug}/sections/{sectionSlug}/lessons/{lessonSlug}", wrapper.GetLessonBySlug)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/courses/{courseID}", wrapper.DeleteCourse)
 	})
@@ -1457,12 +2705,36 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/courses/{courseID}", wrapper.UpdateCourse)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseID}/approve", wrapper.ApproveCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseID}/archive", wrapper.ArchiveCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseID}/clone", wrapper.CloneCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseID}/enroll", wrapper.EnrollCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseID}/history", wrapper.GetCourseHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseID}/publish", wrapper.PublishCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseID}/reject", wrapper.RejectCourse)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/courses/{courseID}/sections", wrapper.GetSections)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/courses/{courseID}/sections", wrapper.CreateSection)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/courses/{courseID}/sections/order", wrapper.ReorderSections)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/courses/{courseID}/sections/{sectionID}", wrapper.DeleteSection)
 	})
@@ -1488,7 +2760,25 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		r.Patch(options.BaseURL+"/courses/{courseID}/sections/{sectionID}/lessons/{lessonID}", wrapper.UpdateLesson)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/courses/{courseId}/enroll", wrapper.EnrollCourse)
+		r.Get(options.BaseURL+"/courses/{courseID}/sections/{sectionID}/lessons/{lessonID}/revisions", wrapper.GetLessonRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseID}/sections/{sectionID}/lessons/{lessonID}/revisions/diff", wrapper.DiffLessonRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseID}/sections/{sectionID}/lessons/{lessonID}/revisions/{revision}/restore", wrapper.RestoreLessonRevision)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseID}/submit", wrapper.SubmitCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseID}/unpublish", wrapper.UnpublishCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseID}/versions", wrapper.GetCourseVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseID}/versions/{version}", wrapper.GetCourseVersion)
 	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/me", wrapper.DeleteCurrentUser)
@@ -1499,12 +2789,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/me", wrapper.UpdateCurrentUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/me/2fa/totp", wrapper.DisableTotp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/me/2fa/totp", wrapper.EnrollTotp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/me/2fa/totp/verify", wrapper.VerifyTotp)
+	})
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/me/courses/{courseID}/lessons/{lessonID}/progress", wrapper.UpdateLessonProgress)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/export", wrapper.ExportCurrentUser)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/me/progress", wrapper.GetUserProgress)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/sections/{sectionID}/lessons/order", wrapper.ReorderLessons)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/users/{userId}", wrapper.DeleteUserById)
 	})
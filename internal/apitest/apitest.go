@@ -0,0 +1,169 @@
+// Package apitest — обвязка для интеграционных тестов API.
+//
+// Harness поднимает Server на временной базе Postgres с применёнными миграциями
+// и miniredis вместо Redis, а Session даёт сгенерированный клиент api.ClientWithResponses
+// с токеном пользователя, через который вызывается любой маршрут api.ServerInterface:
+//
+//	h := apitest.New(t)
+//	admin := h.NewUser(models.RoleAdmin)
+//	resp, err := admin.Client.CreateCourseWithResponse(ctx, api.CreateCourseJSONRequestBody{...})
+package apitest
+
+import (
+	"context"
+	"fmt"
+	"handbooks/internal/config"
+	"handbooks/internal/database"
+	handlers "handbooks/internal/handler"
+	"handbooks/internal/logger"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+)
+
+// Harness — запущенный сервер и его зависимости на время одного теста
+type Harness struct {
+	T testing.TB
+
+	Config *config.Config
+	Server *handlers.Server
+	HTTP   *httptest.Server
+	DB     *pgxpool.Pool
+	Redis  *redis.Client
+	// Miniredis — управление подменой Redis: FastForward для истечения TTL, FlushAll и т.п.
+	Miniredis *miniredis.Miniredis
+}
+
+// Option — изменение конфига до старта сервера
+type Option func(*config.Config)
+
+// testConfig — минимальный конфиг: rate limit выключен, логи только предупреждения
+const testConfig = `
+[handbooks]
+logLevel = 4
+logFormat = "text"
+
+[database]
+url = %q
+
+[server]
+host = "127.0.0.1"
+port = 0
+readTimeout = "10s"
+writeTimeout = "10s"
+idleTimeout = "10s"
+shutdownTimeout = "5s"
+
+[redis]
+addr = %q
+accessTokenTTL = "15m"
+refreshTokenTTL = "24h"
+
+[rateLimit]
+enabled = false
+
+[jwt]
+key = "apitest-jwt-key"
+issuer = "handbooks-test"
+audience = "handbooks-test"
+`
+
+// New — поднимает сервер для теста; всё останавливается и удаляется в t.Cleanup.
+// В режиме go test -short тест пропускается: нужен Postgres
+func New(t testing.TB, opts ...Option) *Harness {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("apitest: интеграционный тест пропущен в -short")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	mr := miniredis.RunT(t)
+	dbURL := startPostgres(t)
+
+	cfg := loadConfig(t, fmt.Sprintf(testConfig, dbURL, mr.Addr()))
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if err := logger.Setup(logger.Options{Format: cfg.Handbooks.LogFormat, Level: cfg.Handbooks.LogLevel}); err != nil {
+		t.Fatalf("apitest: логгер: %v", err)
+	}
+
+	if err := database.RunMigrations(ctx, dbURL, time.Minute); err != nil {
+		t.Fatalf("apitest: миграции: %v", err)
+	}
+
+	pool, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("apitest: подключение к Postgres: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	srv := handlers.NewServer(ctx, pool, rdb, cfg)
+	ts := httptest.NewTLSServer(srv.Handler())
+	t.Cleanup(func() {
+		ts.Close()
+
+		closeCtx, closeCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer closeCancel()
+		if err := srv.Close(closeCtx); err != nil {
+			t.Logf("apitest: остановка фоновых задач: %v", err)
+		}
+	})
+
+	return &Harness{
+		T:         t,
+		Config:    cfg,
+		Server:    srv,
+		HTTP:      ts,
+		DB:        pool,
+		Redis:     rdb,
+		Miniredis: mr,
+	}
+}
+
+// loadConfig — конфиг через обычный config.NewConfig, чтобы работали дефолты и валидация
+func loadConfig(t testing.TB, toml string) *config.Config {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte(toml), 0o600); err != nil {
+		t.Fatalf("apitest: запись конфига: %v", err)
+	}
+
+	cfg, err := config.NewConfig(context.Background(), path)
+	if err != nil {
+		t.Fatalf("apitest: конфиг: %v", err)
+	}
+
+	return cfg
+}
+
+// httpClient — клиент, доверяющий сертификату тестового сервера, со своей cookie jar:
+// refresh-токен приходит в Secure cookie и без TLS не отправлялся бы обратно
+func (h *Harness) httpClient() *http.Client {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		h.T.Fatalf("apitest: cookie jar: %v", err)
+	}
+
+	return &http.Client{
+		Transport: h.HTTP.Client().Transport,
+		Jar:       jar,
+		Timeout:   30 * time.Second,
+	}
+}
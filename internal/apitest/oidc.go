@@ -0,0 +1,256 @@
+package apitest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"handbooks/internal/api"
+	"handbooks/internal/config"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/xid"
+)
+
+const (
+	mockIdPClientID     = "apitest-client"
+	mockIdPClientSecret = "apitest-secret"
+	mockIdPKeyID        = "apitest-key"
+)
+
+// IdPUser — пользователь, который входит у MockIdP
+type IdPUser struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// MockIdP — минимальный OpenID Connect провайдер: discovery, JWKS и token endpoint
+// с PKCE и подписанным RS256 ID-токеном. Страницу входа заменяет Authorize
+type MockIdP struct {
+	t    testing.TB
+	HTTP *httptest.Server
+	key  *rsa.PrivateKey
+
+	mu     sync.Mutex
+	grants map[string]idpGrant // выданные, но ещё не обменянные коды
+}
+
+// idpGrant — то, что провайдер запомнил при авторизации до обмена кода
+type idpGrant struct {
+	user      IdPUser
+	clientID  string
+	nonce     string
+	challenge string
+}
+
+// NewMockIdP — запускает провайдер на время теста
+func NewMockIdP(t testing.TB) *MockIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("apitest: ключ MockIdP: %v", err)
+	}
+
+	idp := &MockIdP{t: t, key: key, grants: make(map[string]idpGrant)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", idp.discovery)
+	mux.HandleFunc("GET /jwks", idp.jwks)
+	mux.HandleFunc("POST /token", idp.token)
+	idp.HTTP = httptest.NewServer(mux)
+	t.Cleanup(idp.HTTP.Close)
+
+	return idp
+}
+
+// WithOIDCProvider — подключает MockIdP как OIDC-провайдера name.
+// RedirectURL произвольный: callback вызывается клиентом API напрямую, см. Harness.OAuthCallback
+func WithOIDCProvider(name string, idp *MockIdP) Option {
+	return func(cfg *config.Config) {
+		if cfg.OAuth.Providers == nil {
+			cfg.OAuth.Providers = make(map[string]config.OAuthProvider)
+		}
+		cfg.OAuth.Providers[name] = config.OAuthProvider{
+			Issuer:       idp.HTTP.URL,
+			ClientID:     mockIdPClientID,
+			ClientSecret: mockIdPClientSecret,
+			RedirectURL:  "https://handbooks.test/auth/oauth/" + name + "/callback",
+			Scopes:       []string{"openid", "email", "profile"},
+		}
+	}
+}
+
+// Authorize — пользователь входит у провайдера по authURL из редиректа AuthOAuthStart.
+// Возвращает code и state, с которыми провайдер вернул бы его на RedirectURL
+func (idp *MockIdP) Authorize(authURL string, user IdPUser) (code, state string) {
+	idp.t.Helper()
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		idp.t.Fatalf("apitest: authURL %q: %v", authURL, err)
+	}
+	q := u.Query()
+
+	if q.Get("response_type") != "code" || q.Get("code_challenge_method") != "S256" {
+		idp.t.Fatalf("apitest: MockIdP ожидает code flow с PKCE S256: %s", authURL)
+	}
+
+	code = xid.New().String()
+
+	idp.mu.Lock()
+	idp.grants[code] = idpGrant{
+		user:      user,
+		clientID:  q.Get("client_id"),
+		nonce:     q.Get("nonce"),
+		challenge: q.Get("code_challenge"),
+	}
+	idp.mu.Unlock()
+
+	return code, q.Get("state")
+}
+
+func (idp *MockIdP) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                idp.HTTP.URL,
+		"authorization_endpoint":                idp.HTTP.URL + "/authorize",
+		"token_endpoint":                        idp.HTTP.URL + "/token",
+		"jwks_uri":                              idp.HTTP.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (idp *MockIdP) jwks(w http.ResponseWriter, _ *http.Request) {
+	pub := idp.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"kid": mockIdPKeyID,
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+// token — обмен кода: проверяет клиента и PKCE, код одноразовый
+func (idp *MockIdP) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	clientID, secret, ok := r.BasicAuth()
+	if !ok {
+		clientID, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != mockIdPClientID || secret != mockIdPClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	code := r.PostForm.Get("code")
+	idp.mu.Lock()
+	grant, ok := idp.grants[code]
+	delete(idp.grants, code)
+	idp.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || grant.clientID != clientID || base64.RawURLEncoding.EncodeToString(sum[:]) != grant.challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            idp.HTTP.URL,
+		"aud":            clientID,
+		"sub":            grant.user.Subject,
+		"iat":            now.Unix(),
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"nonce":          grant.nonce,
+		"email":          grant.user.Email,
+		"email_verified": grant.user.EmailVerified,
+		"name":           grant.user.Name,
+	})
+	idToken.Header["kid"] = mockIdPKeyID
+
+	signed, err := idToken.SignedString(idp.key)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": xid.New().String(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     signed,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// OAuthCallback — вход через провайдера целиком: GET /auth/oauth/{provider} без следования
+// редиректу, вход user у idp и GET callback с полученными code и state.
+// Возвращает ответ callback как есть: токены, challenge 2FA или reauth-токен
+func (h *Harness) OAuthCallback(provider string, idp *MockIdP, user IdPUser, params api.AuthOAuthStartParams) *api.AuthOAuthCallbackResponse {
+	h.T.Helper()
+	ctx := context.Background()
+
+	req, err := api.NewAuthOAuthStartRequest(h.HTTP.URL, provider, &params)
+	if err != nil {
+		h.T.Fatalf("apitest: запрос AuthOAuthStart: %v", err)
+	}
+
+	client := h.httpClient()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		h.T.Fatalf("apitest: AuthOAuthStart %s: %v", provider, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		h.T.Fatalf("apitest: AuthOAuthStart %s: ожидался 302, получен %d", provider, resp.StatusCode)
+	}
+
+	code, state := idp.Authorize(resp.Header.Get("Location"), user)
+
+	callback, err := h.Anonymous().Client.AuthOAuthCallbackWithResponse(ctx, provider, &api.AuthOAuthCallbackParams{
+		Code:  code,
+		State: state,
+	})
+	if err != nil {
+		h.T.Fatalf("apitest: AuthOAuthCallback %s: %v", provider, err)
+	}
+
+	return callback
+}
+
+// OAuthLogin — вход через провайдера для пользователя без 2FA
+func (h *Harness) OAuthLogin(provider string, idp *MockIdP, user IdPUser) *Session {
+	h.T.Helper()
+
+	resp := h.OAuthCallback(provider, idp, user, api.AuthOAuthStartParams{})
+	return h.SessionFromAuth(resp.StatusCode(), resp.Body)
+}
@@ -0,0 +1,221 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"handbooks/internal/api"
+	handlers "handbooks/internal/handler"
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/pquerna/otp/totp"
+	"github.com/rs/xid"
+)
+
+var jwtParser = jwt.NewParser()
+
+// DefaultPassword — пароль пользователей, создаваемых через NewUser
+const DefaultPassword = "apitest-password"
+
+// Session — клиент API от имени пользователя (или анонимный, если Token пуст)
+type Session struct {
+	h *Harness
+
+	Email  string
+	UserID uuid.UUID
+	Token  string
+	// Client — сгенерированный клиент со всеми маршрутами api.ServerInterface;
+	// токен подставляется в Authorization автоматически
+	Client *api.ClientWithResponses
+}
+
+// Anonymous — сессия без токена, для публичных маршрутов
+func (h *Harness) Anonymous() *Session {
+	s := &Session{h: h}
+	s.Client = h.newClient(s)
+	return s
+}
+
+// Register — регистрация через POST /auth/register
+func (h *Harness) Register(email, password, fullName string) *Session {
+	h.T.Helper()
+
+	s := h.Anonymous()
+	resp, err := s.Client.AuthRegisterUserWithResponse(context.Background(), api.AuthRegisterUserJSONRequestBody{
+		Email:    openapi_types.Email(email),
+		Password: password,
+		FullName: fullName,
+	})
+	if err != nil {
+		h.T.Fatalf("apitest: register %s: %v", email, err)
+	}
+	s.useToken(resp.StatusCode(), resp.Body)
+
+	return s
+}
+
+// Login — вход через POST /auth/login
+func (h *Harness) Login(email, password string) *Session {
+	h.T.Helper()
+
+	s := h.Anonymous()
+	resp, err := s.Client.AuthLoginUserWithResponse(context.Background(), api.AuthLoginUserJSONRequestBody{
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		h.T.Fatalf("apitest: login %s: %v", email, err)
+	}
+	s.useToken(resp.StatusCode(), resp.Body)
+
+	return s
+}
+
+// NewUser — регистрирует пользователя с уникальным email, назначает роль и входит заново,
+// чтобы роль попала в claims токена
+func (h *Harness) NewUser(role string) *Session {
+	h.T.Helper()
+
+	email := fmt.Sprintf("%s-%s@apitest.local", role, xid.New().String())
+	h.Register(email, DefaultPassword, "Apitest "+role)
+
+	if err := storage.UpdateFields(context.Background(), "users", map[string]any{
+		"role":       role,
+		"updated_at": time.Now(),
+	}, h.DB, func(ub *sqlbuilder.UpdateBuilder) {
+		ub.Where(ub.Equal("email", email))
+	}); err != nil {
+		h.T.Fatalf("apitest: назначение роли %s: %v", role, err)
+	}
+
+	return h.Login(email, DefaultPassword)
+}
+
+// SessionFromAuth — сессия по ответу, выдавшему токены (AuthVerifyMfa, AuthOAuthCallback)
+func (h *Harness) SessionFromAuth(status int, body []byte) *Session {
+	h.T.Helper()
+
+	s := h.Anonymous()
+	s.useToken(status, body)
+
+	return s
+}
+
+// EnableTotp — подключает 2FA через EnrollTotp и VerifyTotp. Возвращает секрет для totp.GenerateCode
+// и одноразовые коды восстановления
+func (s *Session) EnableTotp() (secret string, recoveryCodes []string) {
+	s.h.T.Helper()
+	ctx := context.Background()
+
+	enroll, err := s.Client.EnrollTotpWithResponse(ctx)
+	if err != nil {
+		s.h.T.Fatalf("apitest: EnrollTotp: %v", err)
+	}
+	if enroll.StatusCode() != http.StatusOK {
+		s.h.T.Fatalf("apitest: EnrollTotp: ожидался 200, получен %d: %s", enroll.StatusCode(), enroll.Body)
+	}
+	secret = Data[struct {
+		Secret string `json:"secret"`
+	}](s.h.T, enroll.Body, "totp").Secret
+
+	code, err := totp.GenerateCode(secret, time.Now())
+	if err != nil {
+		s.h.T.Fatalf("apitest: TOTP-код: %v", err)
+	}
+
+	verify, err := s.Client.VerifyTotpWithResponse(ctx, api.VerifyTotpJSONRequestBody{Code: code})
+	if err != nil {
+		s.h.T.Fatalf("apitest: VerifyTotp: %v", err)
+	}
+	if verify.StatusCode() != http.StatusOK {
+		s.h.T.Fatalf("apitest: VerifyTotp: ожидался 200, получен %d: %s", verify.StatusCode(), verify.Body)
+	}
+	recoveryCodes = Data[struct {
+		RecoveryCodes []string `json:"recoveryCodes"`
+	}](s.h.T, verify.Body, "totp").RecoveryCodes
+
+	return secret, recoveryCodes
+}
+
+// User — пользователь сессии из базы
+func (s *Session) User() *models.User {
+	s.h.T.Helper()
+
+	user, err := storage.GetOne[models.User](context.Background(), s.h.DB, "users", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("email", s.Email))
+	})
+	if err != nil {
+		s.h.T.Fatalf("apitest: пользователь %s: %v", s.Email, err)
+	}
+
+	return user
+}
+
+// useToken — берёт access_token из ответа /auth/* и определяет пользователя
+func (s *Session) useToken(status int, body []byte) {
+	s.h.T.Helper()
+
+	if status != http.StatusOK {
+		s.h.T.Fatalf("apitest: ожидался 200 при входе, получен %d: %s", status, body)
+	}
+
+	auth := Data[struct {
+		AccessToken string `json:"access_token"`
+	}](s.h.T, body, "auth")
+	s.Token = auth.AccessToken
+
+	claims := &handlers.Claims{}
+	if _, _, err := jwtParser.ParseUnverified(s.Token, claims); err != nil {
+		s.h.T.Fatalf("apitest: разбор access token: %v", err)
+	}
+	s.UserID = claims.ID
+	s.Email = claims.Email
+}
+
+func (h *Harness) newClient(s *Session) *api.ClientWithResponses {
+	client, err := api.NewClientWithResponses(h.HTTP.URL,
+		api.WithHTTPClient(h.httpClient()),
+		api.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			if s.Token != "" {
+				req.Header.Set("Authorization", s.Token)
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		h.T.Fatalf("apitest: клиент API: %v", err)
+	}
+
+	return client
+}
+
+// Data — достаёт data[key] из ApiResponse и раскладывает в T
+func Data[T any](t testing.TB, body []byte, key string) T {
+	t.Helper()
+
+	var resp struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		t.Fatalf("apitest: ответ не ApiResponse: %v: %s", err, body)
+	}
+
+	var out T
+	raw, ok := resp.Data[key]
+	if !ok {
+		t.Fatalf("apitest: в ответе нет data.%s: %s", key, body)
+	}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		t.Fatalf("apitest: data.%s: %v", key, err)
+	}
+
+	return out
+}
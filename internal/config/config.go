@@ -2,9 +2,14 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"handbooks/internal/logger"
 	"log/slog"
+	"net/url"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,7 +23,9 @@ import (
 // Config — основная структура конфигурации
 type Config struct {
 	Handbooks struct {
-		LogLevel slog.Level `koanf:"logLevel"`
+		LogLevel   slog.Level `koanf:"logLevel"`
+		LogFormat  string     `koanf:"logFormat"`
+		RedactKeys []string   `koanf:"redactKeys"`
 	} `koanf:"handbooks"`
 
 	Database struct {
@@ -28,19 +35,29 @@ type Config struct {
 		Password string `koanf:"password"`
 		Name     string `koanf:"name"`
 		SslMode  string `koanf:"sslmode"`
-		URL      string
+		// URL — полная строка подключения; если задана, поля выше не используются
+		URL string `koanf:"url"`
+		// SkipMigrations — не применять миграции при старте (их запускает `handbooks migrate up`)
+		SkipMigrations          bool   `koanf:"skipMigrations"`
+		MigrationLockTimeout    string `koanf:"migrationLockTimeout"`
+		MigrationLockTimeoutDur time.Duration
 	} `koanf:"database"`
 
 	Server struct {
-		Host            string `koanf:"host"`
-		Port            int    `koanf:"port"`
-		ReadTimeout     string `koanf:"readTimeout"`
-		WriteTimeout    string `koanf:"writeTimeout"`
-		IdleTimeout     string `koanf:"idleTimeout"`
-		ReadTimeoutDur  time.Duration
-		WriteTimeoutDur time.Duration
-		IdleTimeoutDur  time.Duration
-		URL             string
+		Host               string   `koanf:"host"`
+		Port               int      `koanf:"port"`
+		ReadTimeout        string   `koanf:"readTimeout"`
+		WriteTimeout       string   `koanf:"writeTimeout"`
+		IdleTimeout        string   `koanf:"idleTimeout"`
+		ShutdownTimeout    string   `koanf:"shutdownTimeout"`
+		DrainDelay         string   `koanf:"drainDelay"`
+		CorsOrigins        []string `koanf:"corsOrigins"`
+		ReadTimeoutDur     time.Duration
+		WriteTimeoutDur    time.Duration
+		IdleTimeoutDur     time.Duration
+		ShutdownTimeoutDur time.Duration
+		DrainDelayDur      time.Duration
+		URL                string
 	} `koanf:"server"`
 
 	Redis struct {
@@ -53,6 +70,48 @@ type Config struct {
 		AccessTokenDur  time.Duration
 	} `koanf:"redis"`
 
+	Auth struct {
+		MaxLoginAttempts int    `koanf:"maxLoginAttempts"`
+		LoginWindow      string `koanf:"loginWindow"`
+		LockoutBase      string `koanf:"lockoutBase"`
+		LockoutMax       string `koanf:"lockoutMax"`
+		TotpIssuer       string `koanf:"totpIssuer"`
+		MfaChallengeTTL  string `koanf:"mfaChallengeTTL"`
+		LoginWindowDur   time.Duration
+		LockoutBaseDur   time.Duration
+		LockoutMaxDur    time.Duration
+		MfaChallengeDur  time.Duration
+	} `koanf:"auth"`
+
+	RateLimit struct {
+		Enabled   bool             `koanf:"enabled"`
+		Limit     int              `koanf:"limit"`
+		Window    string           `koanf:"window"`
+		Groups    []RateLimitGroup `koanf:"groups"`
+		WindowDur time.Duration
+	} `koanf:"rateLimit"`
+
+	OAuth struct {
+		StateTTL    string                   `koanf:"stateTTL"`
+		Providers   map[string]OAuthProvider `koanf:"providers"`
+		StateTTLDur time.Duration
+	} `koanf:"oauth"`
+
+	// Scheduler — фоновая публикация курсов и уроков по publish_at
+	Scheduler struct {
+		Interval    string `koanf:"interval"`
+		IntervalDur time.Duration
+	} `koanf:"scheduler"`
+
+	Tracing struct {
+		Enabled     bool    `koanf:"enabled"`
+		Exporter    string  `koanf:"exporter"`
+		Endpoint    string  `koanf:"endpoint"`
+		Insecure    bool    `koanf:"insecure"`
+		SampleRatio float64 `koanf:"sampleRatio"`
+		ServiceName string  `koanf:"serviceName"`
+	} `koanf:"tracing"`
+
 	JwtOpt struct {
 		Key      string `koanf:"key"`
 		Issuer   string `koanf:"issuer"`
@@ -60,6 +119,47 @@ type Config struct {
 	} `koanf:"jwt"`
 }
 
+// RateLimitGroup — лимит запросов для группы маршрутов.
+// Группа выбирается по регулярному выражению пути и (необязательно) по методам.
+type RateLimitGroup struct {
+	Name      string   `koanf:"name"`
+	Match     string   `koanf:"match"`
+	Methods   []string `koanf:"methods"`
+	Limit     int      `koanf:"limit"`
+	Window    string   `koanf:"window"`
+	WindowDur time.Duration
+	Pattern   *regexp.Regexp
+}
+
+// Matches — подходит ли запрос под группу
+func (g *RateLimitGroup) Matches(method, path string) bool {
+	if len(g.Methods) > 0 && !slices.Contains(g.Methods, method) {
+		return false
+	}
+	return g.Pattern.MatchString(path)
+}
+
+// OAuthProvider — настройки внешнего провайдера входа.
+// Если задан Issuer, провайдер считается OpenID Connect и эндпоинты берутся из discovery,
+// иначе используется обычный OAuth2 (например GitHub) с явными AuthURL/TokenURL/UserInfoURL.
+type OAuthProvider struct {
+	Issuer       string   `koanf:"issuer"`
+	ClientID     string   `koanf:"clientID"`
+	ClientSecret string   `koanf:"clientSecret"`
+	RedirectURL  string   `koanf:"redirectURL"`
+	Scopes       []string `koanf:"scopes"`
+	AuthURL      string   `koanf:"authURL"`
+	TokenURL     string   `koanf:"tokenURL"`
+	UserInfoURL  string   `koanf:"userInfoURL"`
+	EmailsURL    string   `koanf:"emailsURL"`
+}
+
+// defaultRedactKeys — ключи логов, значения которых маскируются, если в конфиге не задано иное
+var defaultRedactKeys = []string{
+	"password", "password_hash", "token", "access_token", "refresh_token", "authorization",
+	"secret", "client_secret", "totp_secret", "code", "email",
+}
+
 // NewConfig - загружает и валидирует конфигурацию
 func NewConfig(ctx context.Context, configPath string) (*Config, error) {
 	if err := godotenv.Load(); err != nil && !os.IsNotExist(err) {
@@ -68,11 +168,39 @@ func NewConfig(ctx context.Context, configPath string) (*Config, error) {
 
 	k := koanf.New(".")
 
-	if err := k.Load(env.Provider("HANDBOOKS_", ".", func(s string) string {
-		return strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(s, "HANDBOOKS_")), "_", ".")
+	envKey := func(s string) string {
+		return strings.ReplaceAll(strings.ToLower(s), "_", ".")
+	}
+
+	// HANDBOOKS_<KEY>_FILE — значение читается из файла (секреты, смонтированные в Kubernetes)
+	// и имеет приоритет над HANDBOOKS_<KEY>
+	var (
+		fromFiles = make(map[string]string)
+		fileErrs  []error
+	)
+	if err := k.Load(env.ProviderWithValue("HANDBOOKS_", ".", func(key, value string) (string, any) {
+		key = strings.TrimPrefix(key, "HANDBOOKS_")
+		if name, ok := strings.CutSuffix(key, "_FILE"); ok {
+			secret, err := readSecretFile(value)
+			if err != nil {
+				fileErrs = append(fileErrs, fmt.Errorf("%s: %w", key, err))
+			} else {
+				fromFiles[envKey(name)] = secret
+			}
+			return "", nil
+		}
+		return envKey(key), value
 	}), nil); err != nil {
 		return nil, fmt.Errorf("ошибка загрузки ENV: %w", err)
 	}
+	if err := errors.Join(fileErrs...); err != nil {
+		return nil, fmt.Errorf("ошибка чтения секретов из файлов: %w", err)
+	}
+	for key, value := range fromFiles {
+		if err := k.Set(key, value); err != nil {
+			return nil, fmt.Errorf("ошибка загрузки секрета %s из файла: %w", key, err)
+		}
+	}
 
 	if err := k.Load(file.Provider(configPath), toml.Parser()); err != nil {
 		if !os.IsNotExist(err) {
@@ -97,15 +225,18 @@ func NewConfig(ctx context.Context, configPath string) (*Config, error) {
 	}
 
 	cfg.Server.URL = fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
-	cfg.Database.URL = cfg.makePostgresURL()
+	if cfg.Database.URL == "" {
+		cfg.Database.URL = cfg.makePostgresURL()
+	}
 
 	slog.InfoContext(ctx, "Конфигурация загружена успешно",
 		slog.String("db_host", cfg.Database.Host),
 		slog.String("db_user", cfg.Database.User),
-		slog.String("db_pass", maskSecret(cfg.Database.Password)),
+		slog.String("db_pass", logger.MaskSecret(cfg.Database.Password)),
 		slog.String("db_name", cfg.Database.Name),
 		slog.String("redis_addr", cfg.Redis.Addr),
 		slog.String("log_level", cfg.Handbooks.LogLevel.String()),
+		slog.String("log_format", cfg.Handbooks.LogFormat),
 	)
 
 	return &cfg, nil
@@ -116,6 +247,12 @@ func (c *Config) setDefaults() {
 	if c.Handbooks.LogLevel == 0 {
 		c.Handbooks.LogLevel = slog.LevelInfo
 	}
+	if c.Handbooks.LogFormat == "" {
+		c.Handbooks.LogFormat = logger.FormatDev
+	}
+	if c.Handbooks.RedactKeys == nil {
+		c.Handbooks.RedactKeys = defaultRedactKeys
+	}
 	if c.Database.Host == "" {
 		c.Database.Host = "localhost"
 	}
@@ -125,15 +262,91 @@ func (c *Config) setDefaults() {
 	if c.Database.SslMode == "" {
 		c.Database.SslMode = "disable"
 	}
+	if c.Database.MigrationLockTimeout == "" {
+		c.Database.MigrationLockTimeout = "5m"
+	}
 	if c.Server.Host == "" {
 		c.Server.Host = "0.0.0.0"
 	}
 	if c.Server.Port == 0 {
 		c.Server.Port = 3001
 	}
+	if c.Server.ShutdownTimeout == "" {
+		c.Server.ShutdownTimeout = "15s"
+	}
+	if c.Server.DrainDelay == "" {
+		c.Server.DrainDelay = "0s"
+	}
+	if c.Server.CorsOrigins == nil {
+		c.Server.CorsOrigins = []string{"https://*", "http://*"}
+	}
 	if c.Redis.Addr == "" {
 		c.Redis.Addr = "localhost:6379"
 	}
+	if c.Redis.AccessTokenTTL == "" {
+		c.Redis.AccessTokenTTL = "24h"
+	}
+	if c.Redis.RefreshTokenTTL == "" {
+		c.Redis.RefreshTokenTTL = "168h"
+	}
+	if c.Auth.MaxLoginAttempts == 0 {
+		c.Auth.MaxLoginAttempts = 5
+	}
+	if c.Auth.LoginWindow == "" {
+		c.Auth.LoginWindow = "1h"
+	}
+	if c.Auth.LockoutBase == "" {
+		c.Auth.LockoutBase = "1m"
+	}
+	if c.Auth.LockoutMax == "" {
+		c.Auth.LockoutMax = "1h"
+	}
+	if c.Auth.TotpIssuer == "" {
+		c.Auth.TotpIssuer = "Handbooks"
+	}
+	if c.Auth.MfaChallengeTTL == "" {
+		c.Auth.MfaChallengeTTL = "5m"
+	}
+	if c.RateLimit.Limit == 0 {
+		c.RateLimit.Limit = 300
+	}
+	if c.RateLimit.Window == "" {
+		c.RateLimit.Window = "1m"
+	}
+	if c.OAuth.StateTTL == "" {
+		c.OAuth.StateTTL = "10m"
+	}
+	// Список из ENV не задать, поэтому OIDC-провайдеру из ENV нужны стандартные scopes
+	for name, p := range c.OAuth.Providers {
+		if p.Issuer != "" && len(p.Scopes) == 0 {
+			p.Scopes = []string{"openid", "email", "profile"}
+			c.OAuth.Providers[name] = p
+		}
+	}
+	if c.Scheduler.Interval == "" {
+		c.Scheduler.Interval = "30s"
+	}
+	if c.Tracing.Exporter == "" {
+		c.Tracing.Exporter = "stdout"
+	}
+	if c.Tracing.Endpoint == "" {
+		c.Tracing.Endpoint = "localhost:4318"
+	}
+	if c.Tracing.SampleRatio == 0 {
+		c.Tracing.SampleRatio = 1
+	}
+	if c.Tracing.ServiceName == "" {
+		c.Tracing.ServiceName = "handbooks"
+	}
+	for i := range c.RateLimit.Groups {
+		g := &c.RateLimit.Groups[i]
+		if g.Limit == 0 {
+			g.Limit = c.RateLimit.Limit
+		}
+		if g.Window == "" {
+			g.Window = c.RateLimit.Window
+		}
+	}
 }
 
 // parseDurations парсит все строковые длительности
@@ -148,6 +361,10 @@ func (c *Config) parseDurations() error {
 		return d, nil
 	}
 
+	c.Database.MigrationLockTimeoutDur, err = parse("migrationLockTimeout", c.Database.MigrationLockTimeout)
+	if err != nil {
+		return err
+	}
 	c.Server.ReadTimeoutDur, err = parse("readTimeout", c.Server.ReadTimeout)
 	if err != nil {
 		return err
@@ -160,6 +377,14 @@ func (c *Config) parseDurations() error {
 	if err != nil {
 		return err
 	}
+	c.Server.ShutdownTimeoutDur, err = parse("shutdownTimeout", c.Server.ShutdownTimeout)
+	if err != nil {
+		return err
+	}
+	c.Server.DrainDelayDur, err = parse("drainDelay", c.Server.DrainDelay)
+	if err != nil {
+		return err
+	}
 	c.Redis.RefreshTokenDur, err = parse("refreshTokenTTL", c.Redis.RefreshTokenTTL)
 	if err != nil {
 		return err
@@ -168,39 +393,128 @@ func (c *Config) parseDurations() error {
 	if err != nil {
 		return err
 	}
+	c.Auth.LoginWindowDur, err = parse("loginWindow", c.Auth.LoginWindow)
+	if err != nil {
+		return err
+	}
+	c.Auth.LockoutBaseDur, err = parse("lockoutBase", c.Auth.LockoutBase)
+	if err != nil {
+		return err
+	}
+	c.Auth.LockoutMaxDur, err = parse("lockoutMax", c.Auth.LockoutMax)
+	if err != nil {
+		return err
+	}
+	c.Auth.MfaChallengeDur, err = parse("mfaChallengeTTL", c.Auth.MfaChallengeTTL)
+	if err != nil {
+		return err
+	}
+	c.OAuth.StateTTLDur, err = parse("oauth.stateTTL", c.OAuth.StateTTL)
+	if err != nil {
+		return err
+	}
+	c.RateLimit.WindowDur, err = parse("rateLimit.window", c.RateLimit.Window)
+	if err != nil {
+		return err
+	}
+	c.Scheduler.IntervalDur, err = parse("scheduler.interval", c.Scheduler.Interval)
+	if err != nil {
+		return err
+	}
+	for i := range c.RateLimit.Groups {
+		g := &c.RateLimit.Groups[i]
+		g.WindowDur, err = parse("rateLimit.groups."+g.Name+".window", g.Window)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
 // validate проверяет обязательные поля
 func (c *Config) validate() error {
-	if c.Database.Host == "" {
-		return fmt.Errorf("database.host обязателен")
+	if c.Database.URL != "" {
+		if _, err := url.Parse(c.Database.URL); err != nil {
+			return fmt.Errorf("database.url невалиден: %w", err)
+		}
+	} else {
+		if c.Database.Host == "" {
+			return fmt.Errorf("database.host обязателен")
+		}
+		if c.Database.User == "" {
+			return fmt.Errorf("database.user обязателен")
+		}
+		if c.Database.Password == "" {
+			return fmt.Errorf("database.password обязателен")
+		}
+		if c.Database.Name == "" {
+			return fmt.Errorf("database.name обязателен")
+		}
 	}
-	if c.Database.User == "" {
-		return fmt.Errorf("database.user обязателен")
+	if c.JwtOpt.Key == "" {
+		return fmt.Errorf("jwt.key обязателен")
 	}
-	if c.Database.Password == "" {
-		return fmt.Errorf("database.password обязателен")
+	if c.Redis.AccessTokenDur <= 0 || c.Redis.RefreshTokenDur <= 0 {
+		return fmt.Errorf("redis.accessTokenTTL и redis.refreshTokenTTL должны быть больше нуля")
 	}
-	if c.Database.Name == "" {
-		return fmt.Errorf("database.name обязателен")
+	switch c.Handbooks.LogFormat {
+	case logger.FormatDev, logger.FormatJSON, logger.FormatText:
+	default:
+		return fmt.Errorf("handbooks.logFormat должен быть dev, json или text, получено %q", c.Handbooks.LogFormat)
 	}
-	if c.JwtOpt.Key == "" {
-		return fmt.Errorf("jwt.key обязателен")
+	for name, p := range c.OAuth.Providers {
+		if p.ClientID == "" {
+			continue // провайдер отключён
+		}
+		if p.RedirectURL == "" {
+			return fmt.Errorf("oauth.providers.%s.redirectURL обязателен", name)
+		}
+		if p.Issuer == "" && (p.AuthURL == "" || p.TokenURL == "" || p.UserInfoURL == "") {
+			return fmt.Errorf("oauth.providers.%s: нужен issuer или authURL, tokenURL и userInfoURL", name)
+		}
+	}
+	if c.Scheduler.IntervalDur < 0 {
+		return fmt.Errorf("scheduler.interval не может быть отрицательным")
+	}
+	if c.Tracing.Exporter != "otlp" && c.Tracing.Exporter != "stdout" {
+		return fmt.Errorf("tracing.exporter должен быть otlp или stdout, получено %q", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		return fmt.Errorf("tracing.sampleRatio должен быть в диапазоне [0, 1]")
+	}
+	for i := range c.RateLimit.Groups {
+		g := &c.RateLimit.Groups[i]
+		if g.Name == "" {
+			return fmt.Errorf("rateLimit.groups[%d].name обязателен", i)
+		}
+		pattern, err := regexp.Compile(g.Match)
+		if err != nil {
+			return fmt.Errorf("rateLimit.groups.%s.match %q: %w", g.Name, g.Match, err)
+		}
+		g.Pattern = pattern
 	}
 	return nil
 }
 
 func (c *Config) makePostgresURL() string {
-	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=%s",
-		c.Database.User,
-		c.Database.Password,
-		c.Database.Host,
-		c.Database.Port,
-		c.Database.Name,
-		c.Database.SslMode,
-	)
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.Database.User, c.Database.Password),
+		Host:     fmt.Sprintf("%s:%d", c.Database.Host, c.Database.Port),
+		Path:     c.Database.Name,
+		RawQuery: "sslmode=" + url.QueryEscape(c.Database.SslMode),
+	}
+	return u.String()
+}
+
+// readSecretFile — содержимое файла с секретом без завершающего перевода строки
+func readSecretFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(b), "\r\n"), nil
 }
 
 // Геттеры (оставляем для совместимости)
@@ -211,14 +525,3 @@ func (c *Config) WriteTimeout() time.Duration         { return c.Server.WriteTim
 func (c *Config) IdleTimeout() time.Duration          { return c.Server.IdleTimeoutDur }
 func (c *Config) RedisAccessTokenDur() time.Duration  { return c.Redis.AccessTokenDur }
 func (c *Config) RedisRefreshTokenDur() time.Duration { return c.Redis.RefreshTokenDur }
-
-// maskSecret — маскировка паролей в логах
-func maskSecret(s string) string {
-	if s == "" {
-		return "<empty>"
-	}
-	if len(s) <= 4 {
-		return "****"
-	}
-	return s[:2] + strings.Repeat("*", len(s)-4) + s[len(s)-2:]
-}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"fmt"
+	"handbooks/internal/logger"
+	"net/url"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// secretKeys — поля конфига, значения которых не выводятся целиком
+var secretKeys = []string{"password", "key", "clientSecret"}
+
+// Flatten — конфиг в виде плоских пар "путь.к.полю" → значение.
+// Учитываются только поля с тегом koanf, производные (*Dur, URL, Pattern) пропускаются.
+func (c *Config) Flatten() map[string]string {
+	out := make(map[string]string)
+	flatten("", reflect.ValueOf(c).Elem(), out)
+	return out
+}
+
+// Masked — то же, что Flatten, но секреты замаскированы
+func (c *Config) Masked() map[string]string {
+	out := c.Flatten()
+	for path, v := range out {
+		if isSecretPath(path) {
+			out[path] = logger.MaskSecret(v)
+		}
+	}
+	// Пароль может быть внутри строки подключения
+	if u, err := url.Parse(out["database.url"]); err == nil && u.User != nil {
+		out["database.url"] = u.Redacted()
+	}
+	return out
+}
+
+// Diff — отсортированный список путей, значения которых отличаются в двух конфигах
+func Diff(a, b *Config) []string {
+	fa, fb := a.Flatten(), b.Flatten()
+
+	var changed []string
+	for path, v := range fa {
+		if w, ok := fb[path]; !ok || w != v {
+			changed = append(changed, path)
+		}
+	}
+	for path := range fb {
+		if _, ok := fa[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+
+	return changed
+}
+
+// SortedKeys — ключи плоского конфига по алфавиту, для стабильного вывода
+func SortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func flatten(prefix string, v reflect.Value, out map[string]string) {
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			tag := t.Field(i).Tag.Get("koanf")
+			if tag == "" {
+				continue
+			}
+			flatten(join(prefix, tag), v.Field(i), out)
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		for _, k := range keys {
+			flatten(join(prefix, k.String()), v.MapIndex(k), out)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Struct {
+			for i := range v.Len() {
+				flatten(join(prefix, fmt.Sprint(i)), v.Index(i), out)
+			}
+			return
+		}
+		out[prefix] = fmt.Sprint(v.Interface())
+	default:
+		out[prefix] = fmt.Sprint(v.Interface())
+	}
+}
+
+func join(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func isSecretPath(path string) bool {
+	last := path[strings.LastIndex(path, ".")+1:]
+	return slices.Contains(secretKeys, last)
+}
@@ -3,37 +3,62 @@ package database
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"handbooks/internal/metrics"
+	"handbooks/migrations"
 	"log/slog"
+	"time"
 
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pressly/goose/v3"
 )
 
 const (
 	pgDriverName    = "pgx"
-	migrationsDir   = "./migrations"
+	migrationsDir   = "."
 	gooseDriverName = "postgres"
+
+	// migrationLockID — ключ pg_advisory_lock, под которым реплики по очереди применяют миграции
+	migrationLockID int64 = 0x68616e64626f6f6b // "handbook"
 )
 
-// NewDatabase создает новое подключение к базе данных
-func NewDatabase(ctx context.Context, url string) (*pgx.Conn, error) {
-	conn, err := pgx.Connect(ctx, url)
+// ErrSchemaBehind — схема БД старее, чем ожидает бинарник
+var ErrSchemaBehind = errors.New("схема БД отстаёт от версии приложения")
+
+func init() {
+	goose.SetBaseFS(migrations.FS)
+}
+
+// NewDatabase создает пул подключений к базе данных с метриками запросов.
+// Метрики самого пула регистрирует вызывающий код (см. metrics.NewPoolCollector)
+func NewDatabase(ctx context.Context, url string) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid database url", slog.String("Error", err.Error()))
+		return nil, err
+	}
+
+	cfg.ConnConfig.Tracer = metrics.QueryTracer{}
+
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
 	if err != nil {
 		slog.ErrorContext(ctx, "Unable to connect to database", slog.String("Error", err.Error()))
 		return nil, err
 	}
 
-	if err := conn.Ping(ctx); err != nil {
+	if err := pool.Ping(ctx); err != nil {
 		slog.ErrorContext(ctx, "Error database ping", slog.String("Error", err.Error()))
+		pool.Close()
 		return nil, err
 	}
 
-	return conn, nil
+	return pool, nil
 }
 
-// RunMigrations запускает миграции базы данных
-func RunMigrations(ctx context.Context, dbURL string) error {
+// RunMigrations запускает миграции базы данных под advisory lock,
+// чтобы одновременно стартующие реплики не применяли их параллельно
+func RunMigrations(ctx context.Context, dbURL string, lockTimeout time.Duration) error {
 	db, err := sql.Open(pgDriverName, dbURL)
 	if err != nil {
 		return fmt.Errorf("не удалось открыть соединение для миграций: %w", err)
@@ -42,19 +67,110 @@ func RunMigrations(ctx context.Context, dbURL string) error {
 
 	goose.SetDialect(gooseDriverName)
 
-	statusErr := goose.Status(db, migrationsDir)
-	if statusErr != nil {
-		slog.WarnContext(ctx, "Не удалось получить статус миграций", "error", statusErr)
+	return withMigrationLock(ctx, db, lockTimeout, func() error {
+		current, _ := goose.GetDBVersion(db)
+		slog.InfoContext(ctx, "Текущая версия БД", "version", current)
+
+		slog.DebugContext(ctx, "Запуск миграций Goose...")
+		if err := goose.UpContext(ctx, db, migrationsDir); err != nil {
+			return fmt.Errorf("ошибка применения миграций: %w", err)
+		}
+
+		slog.InfoContext(ctx, "Миграции успешно применены или уже актуальны")
+		return nil
+	})
+}
+
+// Migrate выполняет команду goose (up, down, status, redo, version) над базой
+func Migrate(ctx context.Context, dbURL, command string, lockTimeout time.Duration) error {
+	db, err := sql.Open(pgDriverName, dbURL)
+	if err != nil {
+		return fmt.Errorf("не удалось открыть соединение для миграций: %w", err)
+	}
+	defer db.Close()
+
+	goose.SetDialect(gooseDriverName)
+
+	return withMigrationLock(ctx, db, lockTimeout, func() error {
+		if err := goose.RunContext(ctx, command, db, migrationsDir); err != nil {
+			return fmt.Errorf("goose %s: %w", command, err)
+		}
+		return nil
+	})
+}
+
+// withMigrationLock — выполняет fn, удерживая pg_advisory_lock на отдельном соединении.
+// Остальные реплики ждут до lockTimeout, а после получения блокировки goose у них уже ничего не делает.
+func withMigrationLock(ctx context.Context, db *sql.DB, lockTimeout time.Duration, fn func() error) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("не удалось получить соединение для блокировки миграций: %w", err)
 	}
+	defer conn.Close()
 
-	current, _ := goose.GetDBVersion(db)
-	slog.InfoContext(ctx, "Текущая версия БД", "version", current)
+	lockCtx, cancel := context.WithTimeout(ctx, lockTimeout)
+	defer cancel()
 
-	slog.DebugContext(ctx, "Запуск миграций Goose...")
-	if err := goose.Up(db, migrationsDir); err != nil {
-		return fmt.Errorf("ошибка применения миграций: %w", err)
+	slog.DebugContext(ctx, "Ожидание блокировки миграций", slog.Int64("lock_id", migrationLockID))
+	if _, err := conn.ExecContext(lockCtx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		return fmt.Errorf("не удалось получить блокировку миграций за %s: %w", lockTimeout, err)
+	}
+
+	defer func() {
+		// Блокировка сессионная: снимаем явно, соединение возвращается в пул
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", migrationLockID); err != nil {
+			slog.ErrorContext(ctx, "Не удалось снять блокировку миграций", "error", err)
+		}
+	}()
+
+	return fn()
+}
+
+// CheckSchemaVersion — ошибка ErrSchemaBehind, если в БД применены не все миграции бинарника
+func CheckSchemaVersion(ctx context.Context, db *pgxpool.Pool) error {
+	expected, err := LatestMigrationVersion()
+	if err != nil {
+		return err
+	}
+
+	current, err := SchemaVersion(ctx, db)
+	if err != nil {
+		return fmt.Errorf("не удалось получить версию схемы: %w", err)
+	}
+
+	if current < expected {
+		return fmt.Errorf("%w: в БД %d, нужна %d — выполните `handbooks migrate up`", ErrSchemaBehind, current, expected)
+	}
+	if current > expected {
+		slog.WarnContext(ctx, "Схема БД новее версии приложения", "current", current, "expected", expected)
 	}
 
-	slog.InfoContext(ctx, "Миграции успешно применены или уже актуальны")
 	return nil
 }
+
+// LatestMigrationVersion возвращает версию самого нового файла миграций
+func LatestMigrationVersion() (int64, error) {
+	migrations, err := goose.CollectMigrations(migrationsDir, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось прочитать миграции: %w", err)
+	}
+
+	last, err := migrations.Last()
+	if err != nil {
+		return 0, err
+	}
+
+	return last.Version, nil
+}
+
+// SchemaVersion возвращает последнюю применённую версию схемы из таблицы goose
+func SchemaVersion(ctx context.Context, db *pgxpool.Pool) (int64, error) {
+	var version int64
+
+	query := fmt.Sprintf("SELECT COALESCE(MAX(version_id), 0) FROM %s WHERE is_applied", goose.TableName())
+	if err := db.QueryRow(ctx, query).Scan(&version); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
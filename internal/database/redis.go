@@ -3,6 +3,8 @@ package database
 import (
 	"context"
 	"handbooks/internal/config"
+	"handbooks/internal/metrics"
+	"handbooks/internal/tracing"
 	"log/slog"
 
 	"github.com/redis/go-redis/v9"
@@ -14,6 +16,8 @@ func NewRedisConnection(ctx context.Context, cfg *config.Config) (*redis.Client,
 		Password: cfg.Redis.Password,
 		DB:       cfg.Redis.DB,
 	})
+	rdb.AddHook(metrics.RedisHook{})
+	rdb.AddHook(tracing.RedisHook{})
 
 	_, err := rdb.Ping(ctx).Result()
 	if err != nil {
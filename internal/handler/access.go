@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+)
+
+// Причины, по которым урок закрыт для пользователя
+const (
+	lockReasonNotEnrolled     = "not_enrolled"
+	lockReasonEnrollmentDays  = "enrollment_days"
+	lockReasonPreviousSection = "previous_section"
+)
+
+// lessonLock — закрыт ли урок для пользователя и когда откроется.
+// UnlocksAt == nil у закрытого урока: дата заранее неизвестна (ждёт прохождения раздела или записи)
+type lessonLock struct {
+	Locked    bool       `json:"locked"`
+	UnlocksAt *time.Time `json:"unlocksAt,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+}
+
+// merge — урок открыт, только когда открыт по обоим правилам; дата — более поздняя из известных
+func (l lessonLock) merge(other lessonLock) lessonLock {
+	if !other.Locked {
+		return l
+	}
+	if !l.Locked {
+		return other
+	}
+	if l.UnlocksAt == nil || other.UnlocksAt == nil {
+		if l.UnlocksAt == nil {
+			return l
+		}
+		return other
+	}
+	if other.UnlocksAt.After(*l.UnlocksAt) {
+		return other
+	}
+	return l
+}
+
+// courseAccess — права пользователя на содержимое уроков одного курса.
+// Читать урок могут команда курса и админы, записанные на курс (с учётом правил drip)
+// и все — уроки разделов с бесплатным превью опубликованного курса.
+type courseAccess struct {
+	manager    bool
+	published  bool
+	content    courseContent
+	enrollment *models.Enrollment
+	// previousDone[sectionID] — пройден ли раздел, предшествующий sectionID
+	previousDone map[uuid.UUID]bool
+	sections     map[uuid.UUID]models.Section
+	now          time.Time
+}
+
+// loadCourseAccess — проверяет роль пользователя в курсе; для остальных загружает запись на курс,
+// разделы, опубликованные уроки и пройденные уроки опубликованной версии, чтобы вычислять правила drip
+func loadCourseAccess(ctx context.Context, db storage.Querier, course *models.Course, claims *Claims) (*courseAccess, error) {
+	manager, err := canManageCourse(ctx, db, course, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	access := &courseAccess{
+		manager:      manager,
+		published:    course.Status == models.CourseStatusPublished,
+		content:      contentFor(course, manager),
+		previousDone: make(map[uuid.UUID]bool),
+		sections:     make(map[uuid.UUID]models.Section),
+		now:          time.Now(),
+	}
+	if manager {
+		return access, nil
+	}
+
+	enrollment, err := storage.GetOne[models.Enrollment](ctx, db, "enrollments", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(
+			sb.Equal("course_id", course.ID),
+			sb.Equal("user_id", claims.ID),
+			sb.In("status", models.EnrollmentStatusActive, models.EnrollmentStatusCompleted),
+		)
+	})
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+	access.enrollment = enrollment
+
+	sections, err := storage.GetAll[models.Section](ctx, access.content.sections(), db, func(sb *sqlbuilder.SelectBuilder) {
+		access.content.scope(sb)
+		sb.Where(sb.Equal("course_id", course.ID))
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(sections, func(a, b models.Section) int { return a.Order - b.Order })
+
+	for _, section := range sections {
+		access.sections[section.ID] = section
+	}
+	if enrollment == nil {
+		// Без записи на курс drip не вычисляется: доступно только бесплатное превью
+		return access, nil
+	}
+
+	lessons, err := storage.GetAll[models.Lesson](ctx, access.content.lessons(), db, func(sb *sqlbuilder.SelectBuilder) {
+		access.content.scope(sb)
+		sb.Where(sb.Equal("course_id", course.ID), lessonVisibleCond)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := storage.GetAll[models.LessonProgress](ctx, "lesson_progress", db, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("course_id", course.ID), sb.Equal("user_id", claims.ID), sb.IsNotNull("completed_at"))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	completed := make(map[uuid.UUID]bool, len(progress))
+	for _, p := range progress {
+		completed[p.LessonID] = true
+	}
+
+	// Раздел пройден, когда пройдены все его опубликованные уроки
+	sectionDone := make(map[uuid.UUID]bool, len(sections))
+	for _, section := range sections {
+		sectionDone[section.ID] = true
+	}
+	for _, lesson := range lessons {
+		if !completed[lesson.ID] {
+			sectionDone[lesson.SectionID] = false
+		}
+	}
+
+	for i, section := range sections {
+		access.previousDone[section.ID] = i == 0 || sectionDone[sections[i-1].ID]
+	}
+
+	return access, nil
+}
+
+// lessonVisibleCond — урок виден ученикам: опубликован и не ждёт publish_at, либо publish_at уже наступил.
+// Последнее нужно для опубликованных версий: планировщик меняет только рабочую копию
+var lessonVisibleCond = visibleLessonsOf("")
+
+// visibleLessonsOf — lessonVisibleCond для таблицы уроков под псевдонимом alias
+func visibleLessonsOf(alias string) string {
+	if alias != "" {
+		alias += "."
+	}
+	return "((" + alias + "publish_at IS NULL AND " + alias + "is_published) OR " + alias + "publish_at <= NOW())"
+}
+
+// lessonVisible — то же, что lessonVisibleCond, для уже загруженного урока
+func lessonVisible(lesson models.Lesson, now time.Time) bool {
+	if lesson.PublishAt == nil {
+		return lesson.IsPublished
+	}
+	return !lesson.PublishAt.After(now)
+}
+
+// lessonLock — закрыт ли урок для пользователя: нет доступа к курсу или не выполнены правила drip
+// раздела и самого урока
+func (a *courseAccess) lessonLock(lesson models.Lesson) lessonLock {
+	if a.manager {
+		return lessonLock{}
+	}
+
+	section := a.sections[lesson.SectionID]
+	if a.enrollment == nil {
+		if section.IsFreePreview && a.published {
+			return lessonLock{}
+		}
+		return lessonLock{Locked: true, Reason: lockReasonNotEnrolled}
+	}
+
+	return a.ruleLock(section.ID, section.DripDays, section.DripAfterPrevious).
+		merge(a.ruleLock(section.ID, lesson.DripDays, lesson.DripAfterPrevious))
+}
+
+func (a *courseAccess) ruleLock(sectionID uuid.UUID, days *int, afterPrevious bool) lessonLock {
+	var lock lessonLock
+
+	if afterPrevious && !a.previousDone[sectionID] {
+		lock = lessonLock{Locked: true, Reason: lockReasonPreviousSection}
+	}
+
+	if days != nil && *days > 0 {
+		unlocksAt := a.enrollment.EnrolledAt.AddDate(0, 0, *days)
+		if a.now.Before(unlocksAt) {
+			lock = lock.merge(lessonLock{Locked: true, UnlocksAt: &unlocksAt, Reason: lockReasonEnrollmentDays})
+		}
+	}
+
+	return lock
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"handbooks/internal/api"
 	"handbooks/internal/models"
 	storage "handbooks/pkg/storage"
@@ -10,7 +11,6 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/gosimple/slug"
 	"github.com/huandu/go-sqlbuilder"
 )
 
@@ -27,33 +27,71 @@ func (s *Server) CreateCourse(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	// Желаемый slug из запроса или название; при совпадении добавляется суффикс
+	base := course.Slug
+	if base == "" {
+		base = course.Title
+	}
+
 	time := time.Now()
 	course.ID = uuid.New().String()
-	course.Slug = slug.Make(course.Title)
+	course.Status = models.CourseStatusDraft
 	course.CreatedID = ctx.Value("user").(*Claims).ID
+	course.SourceCourseID = nil
+	course.PublishedVersionID = nil
 	course.CreatedAt = time
 	course.UpdatedAt = time
 
-	if err := storage.Create(ctx, "courses", course, s.DB); err != nil {
+	course.Slug, err = courseSlugs.generate(ctx, tx, uuid.Nil, base, uuid.Nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error generating course slug", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := storage.Create(ctx, "courses", course, tx); err != nil {
 		slog.ErrorContext(ctx, "Error creating course", slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
 	s.JSON(w, r, http.StatusCreated, course.ID, "course")
 }
 
 // CoursesList implements [api.ServerInterface].
 func (s *Server) GetCourses(w http.ResponseWriter, r *http.Request, params api.GetCoursesParams) {
-	var ctx = r.Context()
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
 
-	courses, err := storage.GetAll[models.Course](ctx, "courses", s.DB)
+	courses, err := storage.GetAll[models.Course](ctx, "courses", s.DB, func(sb *sqlbuilder.SelectBuilder) {
+		hideUnpublishedCourses(sb, claims)
+	})
 	if err != nil {
 		slog.ErrorContext(ctx, "Error getting courses", slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
+	if err := showPublishedVersions(ctx, s.DB, claims, courses); err != nil {
+		slog.ErrorContext(ctx, "Error loading published course versions", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
 	s.JSON(w, r, http.StatusOK, courses, "courses")
 }
 
@@ -64,32 +102,114 @@ func (s *Server) GetCourseByID(w http.ResponseWriter, r *http.Request, courseID
 	course, err := storage.GetOne[models.Course](ctx, s.DB, "courses", func(sb *sqlbuilder.SelectBuilder) {
 		sb.Where(sb.Equal("id", courseID))
 	})
-
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Course not found", "error")
+		return
+	}
 	if err != nil {
 		slog.ErrorContext(ctx, "Error getting course by ID",
 			slog.String("id", courseID),
 			slog.String("error", err.Error()))
-		s.JSON(w, r, http.StatusInternalServerError, nil, "internal server error")
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
-	s.JSON(w, r, http.StatusOK, course, "course")
+	s.serveCourse(w, r, course)
+}
+
+// serveCourse — отдаёт курс; ученики видят описание из опубликованной версии,
+// а неопубликованный курс для них не существует
+func (s *Server) serveCourse(w http.ResponseWriter, r *http.Request, course *models.Course) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	if course.Status != models.CourseStatusPublished {
+		manager, err := canManageCourse(ctx, s.DB, course, claims)
+		if err != nil {
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+		if !manager {
+			s.JSON(w, r, http.StatusNotFound, "Course not found", "error")
+			return
+		}
+	}
+
+	courses := []models.Course{*course}
+	if err := showPublishedVersions(ctx, s.DB, claims, courses); err != nil {
+		slog.ErrorContext(ctx, "Error loading published course version", slog.String("id", course.ID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, courses[0], "course")
 }
 
 // UpdateCourse implements [api.ServerInterface].
 func (s *Server) UpdateCourse(w http.ResponseWriter, r *http.Request, courseID string) {
 	var (
 		ctx    = r.Context()
-		course models.Course
+		claims = ctx.Value("user").(*Claims)
+		body   json.RawMessage
 	)
 
-	if err := json.NewDecoder(r.Body).Decode(&course); err != nil {
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		slog.ErrorContext(ctx, "Error decoding request body", slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
 		return
 	}
 
-	if err := storage.Update[models.Course](ctx, "courses", course, s.DB, func(sb *sqlbuilder.UpdateBuilder) {
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	current, ok := s.lockCourseForEdit(w, r, tx, courseID, claims)
+	if !ok {
+		return
+	}
+
+	// PATCH: поля, которых нет в запросе, остаются прежними
+	course := *current
+	if err := json.Unmarshal(body, &course); err != nil {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+	course.ID, course.Status, course.CreatedID, course.CreatedAt = current.ID, current.Status, current.CreatedID, current.CreatedAt
+	course.SourceCourseID, course.PublishedVersionID = current.SourceCourseID, current.PublishedVersionID
+	course.UpdatedAt = time.Now()
+
+	// Явно заданный slug должен быть свободен; при смене названия без slug он пересоздаётся.
+	// Прежний slug остаётся в истории и отвечает редиректом
+	currentID := uuid.MustParse(current.ID)
+	requested := course.Slug
+	course.Slug = current.Slug
+	switch {
+	case requested != "" && requested != current.Slug:
+		course.Slug, err = courseSlugs.claim(ctx, tx, uuid.Nil, requested, currentID)
+	case course.Title != current.Title:
+		course.Slug, err = courseSlugs.generate(ctx, tx, uuid.Nil, course.Title, currentID)
+	}
+	if errors.Is(err, ErrSlugTaken) {
+		s.JSON(w, r, http.StatusConflict, "Slug is already taken", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error generating course slug", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := courseSlugs.rename(ctx, tx, uuid.Nil, currentID, current.Slug, course.Slug); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := storage.Update[models.Course](ctx, "courses", course, tx, func(sb *sqlbuilder.UpdateBuilder) {
 		sb.Where(sb.Equal("id", courseID))
 	}); err != nil {
 		slog.ErrorContext(ctx, "Error updating course", slog.String("error", err.Error()))
@@ -97,13 +217,22 @@ func (s *Server) UpdateCourse(w http.ResponseWriter, r *http.Request, courseID s
 		return
 	}
 
-	s.JSON(w, r, http.StatusCreated, course.ID, "course_id")
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, course, "course")
 }
 
 // DeleteCourse implements [api.ServerInterface].
 func (s *Server) DeleteCourse(w http.ResponseWriter, r *http.Request, courseID string) {
 	var ctx = r.Context()
 
+	if _, ok := s.loadManagedCourse(w, r, courseID); !ok {
+		return
+	}
+
 	if err := storage.Delete[models.Course](ctx, "courses", s.DB, func(sb *sqlbuilder.SelectBuilder) {
 		sb.Where(sb.Equal("id", courseID))
 	}); err != nil {
@@ -114,3 +243,20 @@ func (s *Server) DeleteCourse(w http.ResponseWriter, r *http.Request, courseID s
 
 	s.JSON(w, r, http.StatusOK, true, "course")
 }
+
+// hideUnpublishedCourses — оставляет ученикам только опубликованные курсы, чей publish_at наступил.
+// Админы и команда курса видят его в любом статусе
+func hideUnpublishedCourses(sb *sqlbuilder.SelectBuilder, claims *Claims) {
+	if claims.Role == models.RoleAdmin {
+		return
+	}
+
+	sb.Where(sb.Or(
+		sb.And(
+			sb.Equal("status", models.CourseStatusPublished),
+			sb.Or("publish_at IS NULL", "publish_at <= NOW()"),
+		),
+		sb.Equal("created_id", claims.ID),
+		"EXISTS (SELECT 1 FROM course_instructors ci WHERE ci.course_id = courses.id AND ci.user_id = "+sb.Var(claims.ID)+")",
+	))
+}
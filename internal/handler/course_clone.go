@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"handbooks/internal/api"
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+)
+
+// CloneCourse implements [api.ServerInterface].
+// Копирует курс с разделами и уроками одной транзакцией. Копия — черновик с новыми id и slug курса
+// (slug разделов и уроков уникальны в пределах родителя и сохраняются), владелец — тот, кто копирует. Записи на курс, прогресс и история статусов не копируются.
+func (s *Server) CloneCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	var req api.CourseCloneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	// FOR SHARE: структура курса не поменяется, пока копируем (см. lockCourseForEdit)
+	source, err := storage.GetOne[models.Course](ctx, tx, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID)).ForShare()
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Course not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course by ID", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	allowed, err := canManageCourse(ctx, tx, source, claims)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	if !allowed {
+		s.JSON(w, r, http.StatusForbidden, "Forbidden", "error")
+		return
+	}
+
+	title := source.Title + " (копия)"
+	if req.Title != nil {
+		title = strings.TrimSpace(*req.Title)
+		if len([]rune(title)) < 3 {
+			s.JSON(w, r, http.StatusBadRequest, "Title is too short", "error")
+			return
+		}
+	}
+
+	now := time.Now()
+	sourceID := uuid.MustParse(source.ID)
+
+	clone := *source
+	clone.ID = uuid.New().String()
+	clone.Title = title
+	clone.Status = models.CourseStatusDraft
+	clone.PublishAt = nil
+	clone.CreatedID = claims.ID
+	clone.SourceCourseID = &sourceID
+	clone.PublishedVersionID = nil
+	clone.CreatedAt = now
+	clone.UpdatedAt = now
+
+	clone.Slug, err = courseSlugs.generate(ctx, tx, uuid.Nil, title, uuid.Nil)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := storage.Create(ctx, "courses", clone, tx); err != nil {
+		slog.ErrorContext(ctx, "Error creating course clone", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	sections, err := storage.GetAll[models.Section](ctx, "sections", tx, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("course_id", source.ID))
+	})
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	cloneID := uuid.MustParse(clone.ID)
+	sectionIDs := make(map[uuid.UUID]uuid.UUID, len(sections))
+	for _, section := range sections {
+		oldID := section.ID
+		section.ID = uuid.New()
+		section.CourseID = cloneID
+		section.CreatedID = claims.ID
+		section.CreatedAt = now
+		section.UpdatedAt = now
+
+		if err := storage.Create(ctx, "sections", section, tx); err != nil {
+			slog.ErrorContext(ctx, "Error cloning section", slog.String("section_id", oldID.String()), slog.String("error", err.Error()))
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+		sectionIDs[oldID] = section.ID
+	}
+
+	lessons, err := storage.GetAll[models.Lesson](ctx, "lessons", tx, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("course_id", source.ID))
+	})
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	for _, lesson := range lessons {
+		oldID, oldSectionID := lesson.ID, lesson.SectionID
+		lesson.ID = uuid.New()
+		lesson.CourseID = cloneID
+		lesson.SectionID = sectionIDs[oldSectionID]
+		lesson.CreatedID = claims.ID
+		lesson.PublishAt = nil
+		lesson.CreatedAt = now
+		lesson.UpdatedAt = now
+
+		if err := storage.Create(ctx, "lessons", lesson, tx); err != nil {
+			slog.ErrorContext(ctx, "Error cloning lesson", slog.String("lesson_id", oldID.String()), slog.String("error", err.Error()))
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+		// История ревизий не копируется: у копии она начинается с текущего содержимого
+		if _, err := recordLessonRevision(ctx, tx, lesson.ID, lesson.Content, claims.ID, nil); err != nil {
+			slog.ErrorContext(ctx, "Error recording lesson revision", slog.String("lesson_id", oldID.String()), slog.String("error", err.Error()))
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+	}
+
+	if req.CopyInstructors != nil && *req.CopyInstructors {
+		query, args := sqlbuilder.WithFlavor(sqlbuilder.Buildf(
+			"INSERT INTO course_instructors (course_id, user_id, is_main, position, bio_on_course) "+
+				"SELECT %v, user_id, is_main, position, bio_on_course FROM course_instructors WHERE course_id = %v",
+			clone.ID, source.ID,
+		), sqlbuilder.PostgreSQL).Build()
+
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			slog.ErrorContext(ctx, "Error cloning course instructors", slog.String("error", err.Error()))
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	slog.InfoContext(ctx, "course cloned",
+		slog.String("source_id", source.ID),
+		slog.String("course_id", clone.ID),
+		slog.Int("sections", len(sections)),
+		slog.Int("lessons", len(lessons)),
+	)
+
+	s.JSON(w, r, http.StatusCreated, clone, "course")
+}
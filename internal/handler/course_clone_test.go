@@ -0,0 +1,142 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"handbooks/internal/api"
+	"handbooks/internal/apitest"
+	"handbooks/internal/models"
+
+	"github.com/google/uuid"
+)
+
+func TestCloneCourse(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	admin := h.NewUser(models.RoleAdmin)
+	student := h.NewUser(models.RoleStudent)
+
+	source := createCourse(t, owner, true)
+	publishCourse(t, owner, admin, source.ID)
+
+	original, err := owner.Client.GetCourseByIDWithResponse(ctx, source.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "source course", original.StatusCode(), http.StatusOK)
+	sourceCourse := apitest.Data[models.Course](t, original.Body, "course")
+
+	resp, err := owner.Client.CloneCourseWithResponse(ctx, source.ID, api.CloneCourseJSONRequestBody{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "clone", resp.StatusCode(), http.StatusCreated)
+	clone := apitest.Data[models.Course](t, resp.Body, "course")
+
+	t.Run("clone is a new draft", func(t *testing.T) {
+		if clone.ID == source.ID || clone.Status != models.CourseStatusDraft || clone.PublishedVersionID != nil {
+			t.Fatalf("unexpected clone: %+v", clone)
+		}
+		if clone.SourceCourseID == nil || clone.SourceCourseID.String() != source.ID {
+			t.Fatalf("clone source: %v, want %s", clone.SourceCourseID, source.ID)
+		}
+		if clone.Title != sourceCourse.Title+" (копия)" || clone.Slug == sourceCourse.Slug {
+			t.Fatalf("clone title %q, slug %q", clone.Title, clone.Slug)
+		}
+		if clone.CreatedID != owner.User().ID {
+			t.Fatalf("clone owner %s, want %s", clone.CreatedID, owner.User().ID)
+		}
+	})
+
+	t.Run("content is copied with new ids", func(t *testing.T) {
+		sections, err := owner.Client.GetSectionsWithResponse(ctx, clone.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "clone sections", sections.StatusCode(), http.StatusOK)
+
+		list := apitest.Data[[]models.Section](t, sections.Body, "sections")
+		if len(list) != 1 || list[0].ID.String() == source.SectionID || list[0].Title != "Введение" {
+			t.Fatalf("clone sections: %+v", list)
+		}
+
+		lessons, err := owner.Client.GetLessonsWithResponse(ctx, clone.ID, list[0].ID.String(), &api.GetLessonsParams{PublishedOnly: ptr(false)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "clone lessons", lessons.StatusCode(), http.StatusOK)
+
+		copied := apitest.Data[[]models.Lesson](t, lessons.Body, "lessons")
+		if len(copied) != 1 || copied[0].ID.String() == source.LessonID || copied[0].Content != "# Первый урок" {
+			t.Fatalf("clone lessons: %+v", copied)
+		}
+
+		// История ревизий начинается заново
+		var revisions int
+		if err := h.DB.QueryRow(ctx, "SELECT COUNT(*) FROM lesson_revisions WHERE lesson_id = $1", copied[0].ID).Scan(&revisions); err != nil {
+			t.Fatal(err)
+		}
+		if revisions != 1 {
+			t.Fatalf("clone lesson has %d revisions, want 1", revisions)
+		}
+	})
+
+	t.Run("clone is independent of the source", func(t *testing.T) {
+		sections, err := owner.Client.GetSectionsWithResponse(ctx, clone.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		section := apitest.Data[[]models.Section](t, sections.Body, "sections")[0]
+
+		update, err := owner.Client.UpdateSectionWithResponse(ctx, clone.ID, section.ID.String(), api.UpdateSectionJSONRequestBody{Title: ptr("Только в копии")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "update clone section", update.StatusCode(), http.StatusOK)
+
+		got, err := owner.Client.GetSectionByIDWithResponse(ctx, source.ID, source.SectionID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "source section", got.StatusCode(), http.StatusOK)
+		if title := apitest.Data[models.Section](t, got.Body, "section").Title; title != "Введение" {
+			t.Fatalf("source section changed: %q", title)
+		}
+	})
+
+	t.Run("custom title", func(t *testing.T) {
+		title := "Копия " + uuid.NewString()
+		resp, err := admin.Client.CloneCourseWithResponse(ctx, source.ID, api.CloneCourseJSONRequestBody{Title: ptr(title)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "clone with title", resp.StatusCode(), http.StatusCreated)
+		if got := apitest.Data[models.Course](t, resp.Body, "course"); got.Title != title || got.CreatedID != admin.User().ID {
+			t.Fatalf("clone: %+v", got)
+		}
+
+		short, err := owner.Client.CloneCourseWithResponse(ctx, source.ID, api.CloneCourseJSONRequestBody{Title: ptr("  a ")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "short title", short.StatusCode(), http.StatusBadRequest)
+	})
+
+	t.Run("only managers clone", func(t *testing.T) {
+		denied, err := student.Client.CloneCourseWithResponse(ctx, source.ID, api.CloneCourseJSONRequestBody{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "student clone", denied.StatusCode(), http.StatusForbidden)
+
+		missing, err := owner.Client.CloneCourseWithResponse(ctx, uuid.NewString(), api.CloneCourseJSONRequestBody{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "unknown course", missing.StatusCode(), http.StatusNotFound)
+	})
+}
@@ -0,0 +1,319 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"handbooks/internal/api"
+	"handbooks/internal/apitest"
+	"handbooks/internal/models"
+
+	"github.com/google/uuid"
+)
+
+func TestCourseWorkflow(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	admin := h.NewUser(models.RoleAdmin)
+	stranger := h.NewUser(models.RoleInstructor)
+
+	empty := createCourse(t, owner, false)
+	resp, err := owner.Client.SubmitCourseWithResponse(ctx, empty.ID, api.SubmitCourseJSONRequestBody{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusUnprocessableEntity {
+		t.Fatalf("submit course without sections: expected 422, got %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	course := createCourse(t, owner, true)
+	none := api.CourseTransitionRequest{}
+
+	r1, err := stranger.Client.SubmitCourseWithResponse(ctx, course.ID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, r1.StatusCode(), r1.Body, http.StatusForbidden)
+
+	r2, err := owner.Client.SubmitCourseWithResponse(ctx, course.ID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := transition(t, r2.StatusCode(), r2.Body, http.StatusOK); got != models.CourseStatusInReview {
+		t.Fatalf("after submit: %s", got)
+	}
+
+	// Одобряет и отклоняет только администратор, отклонение — с комментарием
+	r3, err := owner.Client.ApproveCourseWithResponse(ctx, course.ID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, r3.StatusCode(), r3.Body, http.StatusForbidden)
+
+	r4, err := admin.Client.RejectCourseWithResponse(ctx, course.ID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, r4.StatusCode(), r4.Body, http.StatusBadRequest)
+
+	r5, err := admin.Client.RejectCourseWithResponse(ctx, course.ID, api.CourseTransitionRequest{Comment: ptr("Добавьте второй урок")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := transition(t, r5.StatusCode(), r5.Body, http.StatusOK); got != models.CourseStatusDraft {
+		t.Fatalf("after reject: %s", got)
+	}
+
+	// Публиковать можно только одобренный курс
+	r6, err := owner.Client.PublishCourseWithResponse(ctx, course.ID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, r6.StatusCode(), r6.Body, http.StatusConflict)
+
+	r7, err := owner.Client.SubmitCourseWithResponse(ctx, course.ID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, r7.StatusCode(), r7.Body, http.StatusOK)
+
+	r8, err := admin.Client.ApproveCourseWithResponse(ctx, course.ID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := transition(t, r8.StatusCode(), r8.Body, http.StatusOK); got != models.CourseStatusApproved {
+		t.Fatalf("after approve: %s", got)
+	}
+
+	r9, err := owner.Client.PublishCourseWithResponse(ctx, course.ID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := transition(t, r9.StatusCode(), r9.Body, http.StatusOK); got != models.CourseStatusPublished {
+		t.Fatalf("after publish: %s", got)
+	}
+
+	r10, err := owner.Client.ArchiveCourseWithResponse(ctx, course.ID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := transition(t, r10.StatusCode(), r10.Body, http.StatusOK); got != models.CourseStatusArchived {
+		t.Fatalf("after archive: %s", got)
+	}
+
+	history, err := owner.Client.GetCourseHistoryWithResponse(ctx, course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history.StatusCode() != http.StatusOK {
+		t.Fatalf("history: %d: %s", history.StatusCode(), history.Body)
+	}
+
+	var actions []string
+	for _, change := range apitest.Data[[]models.CourseStatusChange](t, history.Body, "history") {
+		actions = append(actions, change.Action)
+	}
+	if len(actions) != 6 {
+		t.Fatalf("expected 6 transitions in history, got %v", actions)
+	}
+}
+
+func TestCourseAccessControl(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	admin := h.NewUser(models.RoleAdmin)
+	student := h.NewUser(models.RoleStudent)
+	other := h.NewUser(models.RoleInstructor)
+
+	course := createCourse(t, owner, true)
+	foreign := createCourse(t, other, true)
+
+	t.Run("non-staff cannot change or delete", func(t *testing.T) {
+		for _, s := range []*apitest.Session{student, other} {
+			c, err := s.Client.UpdateCourseWithResponse(ctx, course.ID, api.UpdateCourseJSONRequestBody{Title: ptr("Hijacked")})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectStatus(t, "update course", c.StatusCode(), http.StatusForbidden)
+
+			sec, err := s.Client.UpdateSectionWithResponse(ctx, course.ID, course.SectionID, api.UpdateSectionJSONRequestBody{Title: ptr("Hijacked")})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectStatus(t, "update section", sec.StatusCode(), http.StatusForbidden)
+
+			l, err := s.Client.UpdateLessonWithResponse(ctx, course.ID, course.SectionID, course.LessonID, api.UpdateLessonJSONRequestBody{Title: ptr("Hijacked")})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectStatus(t, "update lesson", l.StatusCode(), http.StatusForbidden)
+
+			dl, err := s.Client.DeleteLessonWithResponse(ctx, course.ID, course.SectionID, course.LessonID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectStatus(t, "delete lesson", dl.StatusCode(), http.StatusForbidden)
+
+			ds, err := s.Client.DeleteSectionWithResponse(ctx, course.ID, course.SectionID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectStatus(t, "delete section", ds.StatusCode(), http.StatusForbidden)
+
+			dc, err := s.Client.DeleteCourseWithResponse(ctx, course.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectStatus(t, "delete course", dc.StatusCode(), http.StatusForbidden)
+		}
+
+		got, err := owner.Client.GetLessonByIDWithResponse(ctx, course.ID, course.SectionID, course.LessonID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "lesson after denied changes", got.StatusCode(), http.StatusOK)
+		if title := apitest.Data[models.Lesson](t, got.Body, "lesson").Title; title != "Первый урок" {
+			t.Fatalf("lesson changed by non-staff: %q", title)
+		}
+	})
+
+	t.Run("delete is scoped to the course in the path", func(t *testing.T) {
+		// Управляя своим курсом, нельзя удалить урок или раздел чужого, подставив его ID
+		dl, err := other.Client.DeleteLessonWithResponse(ctx, foreign.ID, foreign.SectionID, course.LessonID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "delete foreign lesson", dl.StatusCode(), http.StatusNotFound)
+
+		ds, err := other.Client.DeleteSectionWithResponse(ctx, foreign.ID, course.SectionID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "delete foreign section", ds.StatusCode(), http.StatusNotFound)
+
+		got, err := owner.Client.GetSectionByIDWithResponse(ctx, course.ID, course.SectionID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "section after foreign delete", got.StatusCode(), http.StatusOK)
+	})
+
+	t.Run("admin manages any course", func(t *testing.T) {
+		resp, err := admin.Client.UpdateSectionWithResponse(ctx, course.ID, course.SectionID, api.UpdateSectionJSONRequestBody{Title: ptr("Введение (ред.)")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() >= http.StatusMultipleChoices {
+			t.Fatalf("admin update section: %d: %s", resp.StatusCode(), resp.Body)
+		}
+	})
+
+	t.Run("learner reads published version only", func(t *testing.T) {
+		publishCourse(t, owner, admin, course.ID)
+
+		enroll, err := student.Client.EnrollCourseWithResponse(ctx, course.ID, api.EnrollCourseJSONRequestBody{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "enroll", enroll.StatusCode(), http.StatusOK)
+
+		update, err := owner.Client.UpdateLessonWithResponse(ctx, course.ID, course.SectionID, course.LessonID, api.UpdateLessonJSONRequestBody{
+			Title:       ptr("Черновик правки"),
+			Type:        ptr(api.Text),
+			Content:     ptr("# Черновик правки"),
+			IsPublished: ptr(true),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if update.StatusCode() >= http.StatusMultipleChoices {
+			t.Fatalf("owner update lesson: %d: %s", update.StatusCode(), update.Body)
+		}
+
+		got, err := student.Client.GetLessonByIDWithResponse(ctx, course.ID, course.SectionID, course.LessonID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "learner reads lesson", got.StatusCode(), http.StatusOK)
+		if title := apitest.Data[models.Lesson](t, got.Body, "lesson").Title; title != "Первый урок" {
+			t.Fatalf("learner sees unpublished edit: %q", title)
+		}
+
+		outsider, err := other.Client.GetLessonByIDWithResponse(ctx, foreign.ID, foreign.SectionID, foreign.LessonID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "owner reads own draft course", outsider.StatusCode(), http.StatusOK)
+
+		draft, err := student.Client.GetLessonByIDWithResponse(ctx, foreign.ID, foreign.SectionID, foreign.LessonID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "learner reads unpublished course", draft.StatusCode(), http.StatusNotFound)
+	})
+
+	t.Run("unknown course is not found", func(t *testing.T) {
+		for _, s := range []*apitest.Session{student, admin} {
+			resp, err := s.Client.GetCourseByIDWithResponse(ctx, uuid.NewString())
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectStatus(t, "unknown course", resp.StatusCode(), http.StatusNotFound)
+			if msg := apitest.Data[string](t, resp.Body, "error"); msg != "Course not found" {
+				t.Fatalf("unexpected error: %q", msg)
+			}
+		}
+	})
+}
+
+func TestUpdateCourseKeepsOmittedFields(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	course := createCourse(t, owner, false)
+
+	resp, err := owner.Client.UpdateCourseWithResponse(ctx, course.ID, api.UpdateCourseJSONRequestBody{Subtitle: ptr("Подзаголовок")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "update subtitle", resp.StatusCode(), http.StatusOK)
+
+	updated := apitest.Data[models.Course](t, resp.Body, "course")
+	if updated.Subtitle != "Подзаголовок" || updated.Title == "" || updated.Description != "Описание курса" || updated.CoverURL != "https://example.com/cover.png" {
+		t.Fatalf("omitted fields changed: %+v", updated)
+	}
+	if updated.Status != models.CourseStatusDraft {
+		t.Fatalf("status changed by update: %s", updated.Status)
+	}
+}
+
+func TestUpdateSectionKeepsOmittedFields(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	course := createCourse(t, owner, true)
+
+	preview, err := owner.Client.UpdateSectionWithResponse(ctx, course.ID, course.SectionID, api.UpdateSectionJSONRequestBody{IsFreePreview: ptr(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "update preview", preview.StatusCode(), http.StatusOK)
+
+	resp, err := owner.Client.UpdateSectionWithResponse(ctx, course.ID, course.SectionID, api.UpdateSectionJSONRequestBody{EstimatedTime: ptr(15)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "update estimated time", resp.StatusCode(), http.StatusOK)
+
+	section := apitest.Data[models.Section](t, resp.Body, "section")
+	if section.Title != "Введение" || !section.IsFreePreview || section.EstimatedTime != 15 || section.Slug == "" {
+		t.Fatalf("omitted fields changed: %+v", section)
+	}
+}
@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+)
+
+// Команда курса редактирует рабочую копию (courses, sections, lessons). Публикация сохраняет
+// неизменяемый снимок курса, его разделов и уроков — версию, — и ученики читают только её,
+// поэтому незаконченные правки им не видны до следующей публикации.
+// Разделы и уроки версии сохраняют id рабочей копии, так что прогресс ученика, привязанный
+// к id урока, переносится на новые версии.
+
+// courseContent — откуда читать разделы и уроки курса: рабочая копия или опубликованная версия
+type courseContent struct {
+	// versionID == nil — рабочая копия
+	versionID *uuid.UUID
+}
+
+// contentFor — рабочая копия для команды курса, опубликованная версия для остальных.
+// У неопубликованного курса ученикам читать нечего
+func contentFor(course *models.Course, manager bool) courseContent {
+	if manager {
+		return courseContent{}
+	}
+	version := publishedVersion(course)
+	if version == nil {
+		return courseContent{versionID: &uuid.Nil}
+	}
+	return courseContent{versionID: version}
+}
+
+// publishedVersion — версия, которую видят ученики. Снятый с публикации или архивный курс
+// её не отдаёт, даже если ссылка на последнюю версию осталась
+func publishedVersion(course *models.Course) *uuid.UUID {
+	if course.Status != models.CourseStatusPublished {
+		return nil
+	}
+	return course.PublishedVersionID
+}
+
+func (c courseContent) sections() string {
+	if c.versionID == nil {
+		return "sections"
+	}
+	return "course_version_sections"
+}
+
+func (c courseContent) lessons() string {
+	if c.versionID == nil {
+		return "lessons"
+	}
+	return "course_version_lessons"
+}
+
+// scope — ограничивает выборку разделов или уроков версией
+func (c courseContent) scope(sb *sqlbuilder.SelectBuilder) {
+	if c.versionID != nil {
+		sb.Where(sb.Equal("version_id", *c.versionID))
+	}
+}
+
+// courseVersionView — версия курса вместе с разделами и уроками
+type courseVersionView struct {
+	Version  *models.CourseVersion `json:"version"`
+	Sections []models.Section      `json:"sections"`
+	Lessons  []models.Lesson       `json:"lessons"`
+}
+
+// GetCourseVersions implements [api.ServerInterface].
+func (s *Server) GetCourseVersions(w http.ResponseWriter, r *http.Request, courseID string) {
+	ctx := r.Context()
+
+	if _, ok := s.loadManagedCourse(w, r, courseID); !ok {
+		return
+	}
+
+	versions, err := storage.GetAll[models.CourseVersion](ctx, "course_versions", s.DB, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("course_id", courseID)).OrderByDesc("number")
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course versions", slog.String("course_id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, versions, "versions")
+}
+
+// GetCourseVersion implements [api.ServerInterface].
+func (s *Server) GetCourseVersion(w http.ResponseWriter, r *http.Request, courseID string, version int) {
+	ctx := r.Context()
+
+	if _, ok := s.loadManagedCourse(w, r, courseID); !ok {
+		return
+	}
+
+	v, err := storage.GetOne[models.CourseVersion](ctx, s.DB, "course_versions", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("course_id", courseID), sb.Equal("number", version))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Version not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course version", slog.String("course_id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	content := courseContent{versionID: &v.ID}
+	sections, err := storage.GetAll[models.Section](ctx, content.sections(), s.DB, func(sb *sqlbuilder.SelectBuilder) {
+		content.scope(sb)
+		sb.OrderBy(`"order"`)
+	})
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	lessons, err := storage.GetAll[models.Lesson](ctx, content.lessons(), s.DB, func(sb *sqlbuilder.SelectBuilder) {
+		content.scope(sb)
+		sb.OrderBy("section_id", `"order"`)
+	})
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, courseVersionView{Version: v, Sections: sections, Lessons: lessons}, "version")
+}
+
+// loadManagedCourse — курс, которым пользователь может управлять. При ошибке сам пишет ответ
+func (s *Server) loadManagedCourse(w http.ResponseWriter, r *http.Request, courseID string) (*models.Course, bool) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	course, err := storage.GetOne[models.Course](ctx, s.DB, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Course not found", "error")
+		return nil, false
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course by ID", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return nil, false
+	}
+
+	allowed, err := canManageCourse(ctx, s.DB, course, claims)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return nil, false
+	}
+	if !allowed {
+		s.JSON(w, r, http.StatusForbidden, "Forbidden", "error")
+		return nil, false
+	}
+
+	return course, true
+}
+
+// createCourseVersion — снимок рабочей копии курса следующей по номеру версией.
+// Вызывать под блокировкой строки курса
+func createCourseVersion(ctx context.Context, tx pgx.Tx, course *models.Course, actorID *uuid.UUID) (*models.CourseVersion, error) {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("COALESCE(MAX(number), 0)").From("course_versions").Where(sb.Equal("course_id", course.ID))
+	query, args := sb.Build()
+
+	var last int
+	if err := tx.QueryRow(ctx, query, args...).Scan(&last); err != nil {
+		return nil, err
+	}
+
+	version := &models.CourseVersion{
+		ID:          uuid.New(),
+		CourseID:    uuid.MustParse(course.ID),
+		Number:      last + 1,
+		Title:       course.Title,
+		Subtitle:    course.Subtitle,
+		Description: course.Description,
+		CoverURL:    course.CoverURL,
+		Price:       course.Price,
+		Currency:    course.Currency,
+		Level:       course.Level,
+		PublishedBy: actorID,
+		CreatedAt:   time.Now(),
+	}
+	if err := storage.Create(ctx, "course_versions", *version, tx); err != nil {
+		return nil, err
+	}
+
+	sections, err := storage.GetAll[models.Section](ctx, "sections", tx, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("course_id", course.ID))
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, section := range sections {
+		if err := storage.Create(ctx, "course_version_sections", models.VersionSection{VersionID: version.ID, Section: section}, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	lessons, err := storage.GetAll[models.Lesson](ctx, "lessons", tx, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("course_id", course.ID))
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, lesson := range lessons {
+		if err := storage.Create(ctx, "course_version_lessons", models.VersionLesson{VersionID: version.ID, Lesson: lesson}, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	return version, nil
+}
+
+// showPublishedVersions — подменяет описание курсов опубликованной версией для тех курсов,
+// которыми пользователь не управляет
+func showPublishedVersions(ctx context.Context, db storage.Querier, claims *Claims, courses []models.Course) error {
+	if claims.Role == models.RoleAdmin {
+		return nil
+	}
+
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("course_id::text").From("course_instructors").Where(sb.Equal("user_id", claims.ID))
+	query, args := sb.Build()
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	taught, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+	managed := make(map[string]bool, len(taught))
+	for _, id := range taught {
+		managed[id] = true
+	}
+
+	var ids []any
+	for _, course := range courses {
+		if version := publishedVersion(&course); version != nil && course.CreatedID != claims.ID && !managed[course.ID] {
+			ids = append(ids, *version)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	versions, err := storage.GetAll[models.CourseVersion](ctx, "course_versions", db, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.In("id", ids...))
+	})
+	if err != nil {
+		return err
+	}
+	byID := make(map[uuid.UUID]models.CourseVersion, len(versions))
+	for _, version := range versions {
+		byID[version.ID] = version
+	}
+
+	for i := range courses {
+		course := &courses[i]
+		published := publishedVersion(course)
+		if published == nil || course.CreatedID == claims.ID || managed[course.ID] {
+			continue
+		}
+		if version, ok := byID[*published]; ok {
+			course.Title = version.Title
+			course.Subtitle = version.Subtitle
+			course.Description = version.Description
+			course.CoverURL = version.CoverURL
+			course.Price = version.Price
+			course.Currency = version.Currency
+			course.Level = version.Level
+		}
+	}
+
+	return nil
+}
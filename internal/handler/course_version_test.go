@@ -0,0 +1,191 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"handbooks/internal/api"
+	"handbooks/internal/apitest"
+	"handbooks/internal/models"
+)
+
+// courseVersionView — ответ GetCourseVersion
+type courseVersionView struct {
+	Version  models.CourseVersion
+	Sections []models.Section
+	Lessons  []models.Lesson
+}
+
+func TestCourseVersions(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	admin := h.NewUser(models.RoleAdmin)
+	student := h.NewUser(models.RoleStudent)
+
+	course := createCourse(t, owner, true)
+	publishCourse(t, owner, admin, course.ID)
+
+	editLesson(t, owner, course, "# Вторая редакция")
+	republish, err := owner.Client.PublishCourseWithResponse(ctx, course.ID, api.CourseTransitionRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, republish.StatusCode(), republish.Body, http.StatusOK)
+
+	t.Run("each publish is a version", func(t *testing.T) {
+		resp, err := owner.Client.GetCourseVersionsWithResponse(ctx, course.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "versions", resp.StatusCode(), http.StatusOK)
+
+		versions := apitest.Data[[]models.CourseVersion](t, resp.Body, "versions")
+		if len(versions) != 2 || versions[0].Number != 2 || versions[1].Number != 1 {
+			t.Fatalf("expected versions 2, 1: %+v", versions)
+		}
+		if versions[1].PublishedBy == nil || *versions[1].PublishedBy != owner.User().ID {
+			t.Fatalf("unexpected publisher: %+v", versions[1])
+		}
+	})
+
+	t.Run("version keeps its snapshot", func(t *testing.T) {
+		for number, want := range map[int]string{1: "# Первый урок", 2: "# Вторая редакция"} {
+			resp, err := owner.Client.GetCourseVersionWithResponse(ctx, course.ID, number)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectStatus(t, "version", resp.StatusCode(), http.StatusOK)
+
+			view := apitest.Data[courseVersionView](t, resp.Body, "version")
+			if len(view.Sections) != 1 || len(view.Lessons) != 1 || view.Lessons[0].Content != want {
+				t.Fatalf("version %d: %s", number, resp.Body)
+			}
+			if view.Lessons[0].ID.String() != course.LessonID {
+				t.Fatalf("version %d keeps lesson id %s, want %s", number, view.Lessons[0].ID, course.LessonID)
+			}
+		}
+
+		missing, err := owner.Client.GetCourseVersionWithResponse(ctx, course.ID, 42)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "unknown version", missing.StatusCode(), http.StatusNotFound)
+	})
+
+	t.Run("learner reads the latest version", func(t *testing.T) {
+		resp, err := student.Client.GetLessonByIDWithResponse(ctx, course.ID, course.SectionID, course.LessonID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "learner reads lesson", resp.StatusCode(), http.StatusOK)
+		if content := apitest.Data[models.Lesson](t, resp.Body, "lesson").Content; content != "# Вторая редакция" {
+			t.Fatalf("learner content: %q", content)
+		}
+	})
+
+	t.Run("only managers list versions", func(t *testing.T) {
+		resp, err := student.Client.GetCourseVersionsWithResponse(ctx, course.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "student versions", resp.StatusCode(), http.StatusForbidden)
+	})
+
+	t.Run("versions are immutable", func(t *testing.T) {
+		if _, err := h.DB.Exec(ctx, "UPDATE course_versions SET title = 'forged' WHERE course_id = $1", course.ID); err == nil {
+			t.Fatal("version title was updated")
+		}
+		if _, err := h.DB.Exec(ctx, "UPDATE course_version_lessons SET content = 'forged' WHERE course_id = $1", course.ID); err == nil {
+			t.Fatal("version lesson was updated")
+		}
+	})
+}
+
+func TestDeleteCoursePublisher(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	admin := h.NewUser(models.RoleAdmin)
+
+	course := createCourse(t, owner, true)
+	publishCourse(t, owner, admin, course.ID)
+
+	// Удаление публиковавшего обнуляет published_by, а не упирается в триггер неизменяемости
+	resp, err := owner.Client.DeleteCurrentUserWithResponse(ctx, api.DeleteCurrentUserJSONRequestBody{Password: ptr(apitest.DefaultPassword)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("delete publisher: %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	versions, err := admin.Client.GetCourseVersionsWithResponse(ctx, course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "versions after delete", versions.StatusCode(), http.StatusOK)
+
+	list := apitest.Data[[]models.CourseVersion](t, versions.Body, "versions")
+	if len(list) != 1 || list[0].PublishedBy != nil {
+		t.Fatalf("expected one version without publisher: %+v", list)
+	}
+}
+
+func TestWithdrawnCourseIsHidden(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	admin := h.NewUser(models.RoleAdmin)
+	student := h.NewUser(models.RoleStudent)
+
+	draft := createCourse(t, owner, true)
+	course := createCourse(t, owner, true)
+	publishCourse(t, owner, admin, course.ID)
+
+	enroll, err := student.Client.EnrollCourseWithResponse(ctx, course.ID, api.EnrollCourseJSONRequestBody{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "enroll", enroll.StatusCode(), http.StatusOK)
+
+	visible := func(name string, s *apitest.Session, c testCourse, want int) {
+		t.Helper()
+
+		resp, err := s.Client.GetCourseByIDWithResponse(ctx, c.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, name+": course", resp.StatusCode(), want)
+
+		lesson, err := s.Client.GetLessonByIDWithResponse(ctx, c.ID, c.SectionID, c.LessonID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, name+": lesson", lesson.StatusCode(), want)
+	}
+
+	visible("never published", student, draft, http.StatusNotFound)
+	visible("published", student, course, http.StatusOK)
+
+	unpublish, err := owner.Client.UnpublishCourseWithResponse(ctx, course.ID, api.CourseTransitionRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, unpublish.StatusCode(), unpublish.Body, http.StatusOK)
+
+	visible("unpublished", student, course, http.StatusNotFound)
+	visible("unpublished, owner", owner, course, http.StatusOK)
+
+	archive, err := owner.Client.ArchiveCourseWithResponse(ctx, course.ID, api.CourseTransitionRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, archive.StatusCode(), archive.Body, http.StatusOK)
+
+	visible("archived", student, course, http.StatusNotFound)
+}
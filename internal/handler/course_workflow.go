@@ -0,0 +1,360 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"handbooks/internal/api"
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+)
+
+// courseTransition — описание перехода workflow публикации курса
+type courseTransition struct {
+	from []string
+	to   string
+	// adminOnly — переход доступен только администратору, иначе ещё владельцу и преподавателям курса
+	adminOnly bool
+	// requireComment — без комментария переход не выполняется
+	requireComment bool
+	// checkReadiness — перед переходом курс проверяется на готовность к публикации
+	checkReadiness bool
+}
+
+var courseTransitions = map[string]courseTransition{
+	"submit": {
+		from:           []string{models.CourseStatusDraft},
+		to:             models.CourseStatusInReview,
+		checkReadiness: true,
+	},
+	"approve": {
+		from:      []string{models.CourseStatusInReview},
+		to:        models.CourseStatusApproved,
+		adminOnly: true,
+	},
+	"reject": {
+		from:           []string{models.CourseStatusInReview, models.CourseStatusApproved},
+		to:             models.CourseStatusDraft,
+		adminOnly:      true,
+		requireComment: true,
+	},
+	// Повторная публикация опубликованного курса выпускает новую версию из рабочей копии
+	"publish": {
+		from:           []string{models.CourseStatusApproved, models.CourseStatusPublished},
+		to:             models.CourseStatusPublished,
+		checkReadiness: true,
+	},
+	// Снятый с публикации или архивный курс хранит ссылку на последнюю версию для истории
+	// и прогресса, но ученикам её не отдаёт, см. publishedVersion
+	"unpublish": {
+		from: []string{models.CourseStatusPublished},
+		to:   models.CourseStatusDraft,
+	},
+	"archive": {
+		from: []string{models.CourseStatusDraft, models.CourseStatusApproved, models.CourseStatusPublished},
+		to:   models.CourseStatusArchived,
+	},
+}
+
+// SubmitCourse implements [api.ServerInterface].
+func (s *Server) SubmitCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	s.transitionCourse(w, r, courseID, "submit")
+}
+
+// ApproveCourse implements [api.ServerInterface].
+func (s *Server) ApproveCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	s.transitionCourse(w, r, courseID, "approve")
+}
+
+// RejectCourse implements [api.ServerInterface].
+func (s *Server) RejectCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	s.transitionCourse(w, r, courseID, "reject")
+}
+
+// PublishCourse implements [api.ServerInterface].
+func (s *Server) PublishCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	s.transitionCourse(w, r, courseID, "publish")
+}
+
+// UnpublishCourse implements [api.ServerInterface].
+func (s *Server) UnpublishCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	s.transitionCourse(w, r, courseID, "unpublish")
+}
+
+// ArchiveCourse implements [api.ServerInterface].
+func (s *Server) ArchiveCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	s.transitionCourse(w, r, courseID, "archive")
+}
+
+// GetCourseHistory implements [api.ServerInterface].
+func (s *Server) GetCourseHistory(w http.ResponseWriter, r *http.Request, courseID string) {
+	ctx := r.Context()
+	claims := ctx.Value("user").(*Claims)
+
+	course, err := storage.GetOne[models.Course](ctx, s.DB, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Course not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course by ID", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	allowed, err := canManageCourse(ctx, s.DB, course, claims)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	if !allowed {
+		s.JSON(w, r, http.StatusForbidden, "Forbidden", "error")
+		return
+	}
+
+	history, err := storage.GetAll[models.CourseStatusChange](ctx, "course_status_history", s.DB, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("course_id", courseID))
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course history", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, history, "history")
+}
+
+// transitionCourse — выполняет переход статуса курса и записывает его в историю одной транзакцией
+func (s *Server) transitionCourse(w http.ResponseWriter, r *http.Request, courseID, action string) {
+	ctx := r.Context()
+	claims := ctx.Value("user").(*Claims)
+	transition := courseTransitions[action]
+
+	var req api.CourseTransitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	comment := ""
+	if req.Comment != nil {
+		comment = strings.TrimSpace(*req.Comment)
+	}
+	if transition.requireComment && comment == "" {
+		s.JSON(w, r, http.StatusBadRequest, "Comment is required", "error")
+		return
+	}
+
+	if transition.adminOnly && claims.Role != models.RoleAdmin {
+		s.JSON(w, r, http.StatusForbidden, "Forbidden", "error")
+		return
+	}
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокируем строку курса, чтобы параллельные переходы не прочитали один и тот же исходный статус
+	course, err := storage.GetOne[models.Course](ctx, tx, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID)).ForUpdate()
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Course not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course by ID", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if !transition.adminOnly {
+		allowed, err := canManageCourse(ctx, tx, course, claims)
+		if err != nil {
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+		if !allowed {
+			s.JSON(w, r, http.StatusForbidden, "Forbidden", "error")
+			return
+		}
+	}
+
+	if !slices.Contains(transition.from, course.Status) {
+		s.JSON(w, r, http.StatusConflict, "Transition "+action+" is not allowed from status "+course.Status, "error")
+		return
+	}
+
+	if transition.checkReadiness {
+		problems, err := courseReadinessProblems(ctx, tx, course)
+		if err != nil {
+			slog.ErrorContext(ctx, "Error checking course readiness", slog.String("id", courseID), slog.String("error", err.Error()))
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+		if len(problems) > 0 {
+			s.JSON(w, r, http.StatusUnprocessableEntity, problems, "problems")
+			return
+		}
+	}
+
+	change, err := applyCourseTransition(ctx, tx, course, action, &claims.ID, comment)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	slog.InfoContext(ctx, "course status changed",
+		slog.String("course_id", course.ID),
+		slog.String("action", action),
+		slog.String("from", change.FromStatus),
+		slog.String("to", change.ToStatus),
+	)
+
+	if change.ToStatus == models.CourseStatusPublished {
+		s.emitEvent(ctx, EventCoursePublished, map[string]any{"courseId": course.ID, "scheduled": false})
+	}
+
+	s.JSON(w, r, http.StatusOK, course, "course")
+}
+
+// applyCourseTransition — меняет статус курса и пишет переход в историю в рамках транзакции tx.
+// Проверки прав и исходного статуса остаются на вызывающем. actorID == nil — переход выполнила система.
+func applyCourseTransition(ctx context.Context, tx pgx.Tx, course *models.Course, action string, actorID *uuid.UUID, comment string) (models.CourseStatusChange, error) {
+	transition := courseTransitions[action]
+	now := time.Now()
+
+	fields := map[string]any{
+		"status":     transition.to,
+		"updated_at": now,
+	}
+	// Публикация сохраняет версию, которую увидят ученики; после неё курс больше не ждёт publish_at
+	var version *models.CourseVersion
+	if transition.to == models.CourseStatusPublished {
+		var err error
+		if version, err = createCourseVersion(ctx, tx, course, actorID); err != nil {
+			return models.CourseStatusChange{}, err
+		}
+		fields["publish_at"] = nil
+		fields["published_version_id"] = version.ID
+	}
+
+	if err := storage.UpdateFields(ctx, "courses", fields, tx, func(ub *sqlbuilder.UpdateBuilder) {
+		ub.Where(ub.Equal("id", course.ID))
+	}); err != nil {
+		return models.CourseStatusChange{}, err
+	}
+
+	change := models.CourseStatusChange{
+		ID:         uuid.New(),
+		CourseID:   uuid.MustParse(course.ID),
+		ActorID:    actorID,
+		Action:     action,
+		FromStatus: course.Status,
+		ToStatus:   transition.to,
+		Comment:    comment,
+		CreatedAt:  now,
+	}
+	if err := storage.Create(ctx, "course_status_history", change, tx); err != nil {
+		return models.CourseStatusChange{}, err
+	}
+
+	course.Status = transition.to
+	course.UpdatedAt = now
+	if transition.to == models.CourseStatusPublished {
+		course.PublishAt = nil
+		course.PublishedVersionID = &version.ID
+	}
+
+	return change, nil
+}
+
+// canManageCourse — является ли пользователь админом, владельцем курса или его преподавателем
+func canManageCourse(ctx context.Context, db storage.Querier, course *models.Course, claims *Claims) (bool, error) {
+	if claims.Role == models.RoleAdmin || course.CreatedID == claims.ID {
+		return true, nil
+	}
+
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("1").From("course_instructors").
+		Where(sb.Equal("course_id", course.ID), sb.Equal("user_id", claims.ID))
+	query, args := sb.Build()
+
+	var exists bool
+	if err := db.QueryRow(ctx, "SELECT EXISTS ("+query+")", args...).Scan(&exists); err != nil {
+		slog.ErrorContext(ctx, "cannot check course instructor", slog.String("error", err.Error()))
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// courseReadinessProblems — список причин, по которым курс нельзя публиковать; пустой, если курс готов
+func courseReadinessProblems(ctx context.Context, db storage.Querier, course *models.Course) ([]string, error) {
+	var problems []string
+
+	if strings.TrimSpace(course.Description) == "" {
+		problems = append(problems, "description is empty")
+	}
+	if strings.TrimSpace(course.CoverURL) == "" {
+		problems = append(problems, "cover is not set")
+	}
+
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("s.title").From("sections s").
+		Where(sb.Equal("s.course_id", course.ID)).
+		OrderBy(`s."order"`)
+	sb.SelectMore("EXISTS (SELECT 1 FROM lessons l WHERE l.section_id = s.id AND l.is_published)")
+	query, args := sb.Build()
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sections := 0
+	for rows.Next() {
+		var (
+			title        string
+			hasPublished bool
+		)
+		if err := rows.Scan(&title, &hasPublished); err != nil {
+			return nil, err
+		}
+		sections++
+		if !hasPublished {
+			problems = append(problems, "section \""+title+"\" has no published lessons")
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if sections == 0 {
+		problems = append(problems, "course has no sections")
+	}
+
+	return problems, nil
+}
@@ -1,8 +1,79 @@
 package handlers
 
-import "net/http"
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"handbooks/internal/api"
+	"handbooks/internal/metrics"
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+)
 
 // EnrollCourse implements [api.ServerInterface].
-func (s *Server) EnrollCourse(w http.ResponseWriter, r *http.Request, courseId int) {
-	panic("unimplemented")
+func (s *Server) EnrollCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	var req api.EnrollCourseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	course, err := storage.GetOne[models.Course](ctx, s.DB, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID))
+	})
+	if errors.Is(err, storage.ErrNotFound) || (err == nil && course.Status != models.CourseStatusPublished) {
+		s.JSON(w, r, http.StatusNotFound, "Course not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course by ID", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	// Оплаты пока нет: записаться можно только на бесплатный курс
+	if course.Price > 0 {
+		s.JSON(w, r, http.StatusPaymentRequired, "Payment required", "error")
+		return
+	}
+
+	enrollment := models.Enrollment{
+		ID:         uuid.New(),
+		UserID:     claims.ID,
+		CourseID:   uuid.MustParse(course.ID),
+		Status:     models.EnrollmentStatusActive,
+		EnrolledAt: time.Now(),
+	}
+
+	ib := sqlbuilder.NewStruct(new(models.Enrollment)).For(sqlbuilder.PostgreSQL).InsertInto("enrollments", enrollment)
+	ib.SQL("ON CONFLICT (user_id, course_id) DO NOTHING")
+	query, args := ib.Build()
+
+	tag, err := s.DB.Exec(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error creating enrollment", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	if tag.RowsAffected() == 0 {
+		s.JSON(w, r, http.StatusConflict, "Already enrolled", "error")
+		return
+	}
+
+	metrics.Enrollments.Inc()
+	slog.InfoContext(ctx, "user enrolled", slog.String("course_id", course.ID))
+
+	s.JSON(w, r, http.StatusOK, enrollment, "enrollment")
 }
@@ -0,0 +1,179 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"handbooks/internal/api"
+	"handbooks/internal/apitest"
+	"handbooks/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// courseProgressView — элемент ответа GetUserProgress
+type courseProgressView struct {
+	CourseID string
+	Progress float64
+	Status   string
+}
+
+// userProgress — прогресс s по курсу courseID; ok == false, если s на курс не записан
+func userProgress(t *testing.T, s *apitest.Session, courseID string) (courseProgressView, bool) {
+	t.Helper()
+
+	resp, err := s.Client.GetUserProgressWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "progress", resp.StatusCode(), http.StatusOK)
+
+	for _, p := range apitest.Data[[]courseProgressView](t, resp.Body, "progress") {
+		if p.CourseID == courseID {
+			return p, true
+		}
+	}
+	return courseProgressView{}, false
+}
+
+func TestEnrollCourse(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	admin := h.NewUser(models.RoleAdmin)
+	student := h.NewUser(models.RoleStudent)
+
+	draft := createCourse(t, owner, true)
+	course := createCourse(t, owner, true)
+	publishCourse(t, owner, admin, course.ID)
+
+	paid := createCourse(t, owner, true)
+	price, err := owner.Client.UpdateCourseWithResponse(ctx, paid.ID, api.UpdateCourseJSONRequestBody{Price: ptr(float32(990))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "set price", price.StatusCode(), http.StatusOK)
+	publishCourse(t, owner, admin, paid.ID)
+
+	for name, tt := range map[string]struct {
+		courseID string
+		want     int
+	}{
+		"unknown course": {uuid.NewString(), http.StatusNotFound},
+		"draft course":   {draft.ID, http.StatusNotFound},
+		"paid course":    {paid.ID, http.StatusPaymentRequired},
+	} {
+		resp, err := student.Client.EnrollCourseWithResponse(ctx, tt.courseID, api.EnrollCourseJSONRequestBody{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, name, resp.StatusCode(), tt.want)
+	}
+
+	resp, err := student.Client.EnrollCourseWithResponse(ctx, course.ID, api.EnrollCourseJSONRequestBody{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "enroll", resp.StatusCode(), http.StatusOK)
+	enrollment := apitest.Data[models.Enrollment](t, resp.Body, "enrollment")
+	if enrollment.UserID != student.User().ID || enrollment.CourseID.String() != course.ID || enrollment.Status != models.EnrollmentStatusActive {
+		t.Fatalf("unexpected enrollment: %+v", enrollment)
+	}
+
+	again, err := student.Client.EnrollCourseWithResponse(ctx, course.ID, api.EnrollCourseJSONRequestBody{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "enroll twice", again.StatusCode(), http.StatusConflict)
+
+	if p, ok := userProgress(t, student, course.ID); !ok || p.Progress != 0 || p.Status != models.EnrollmentStatusActive {
+		t.Fatalf("progress after enroll: %+v, %v", p, ok)
+	}
+}
+
+func TestLessonProgress(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	admin := h.NewUser(models.RoleAdmin)
+	student := h.NewUser(models.RoleStudent)
+	stranger := h.NewUser(models.RoleStudent)
+
+	course := createCourse(t, owner, true)
+	second, err := owner.Client.CreateLessonWithResponse(ctx, course.ID, course.SectionID, api.CreateLessonJSONRequestBody{
+		Title:       "Второй урок",
+		Type:        api.LessonCreateTypeText,
+		Content:     ptr("# Второй урок"),
+		IsPublished: ptr(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "create lesson", second.StatusCode(), http.StatusCreated)
+	secondID := apitest.Data[models.Lesson](t, second.Body, "lesson").ID.String()
+	publishCourse(t, owner, admin, course.ID)
+
+	enroll, err := student.Client.EnrollCourseWithResponse(ctx, course.ID, api.EnrollCourseJSONRequestBody{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "enroll", enroll.StatusCode(), http.StatusOK)
+
+	update := func(t *testing.T, s *apitest.Session, lessonID string, body api.UpdateLessonProgressJSONRequestBody, want int) models.LessonProgress {
+		t.Helper()
+
+		resp, err := s.Client.UpdateLessonProgressWithResponse(ctx, course.ID, lessonID, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "update progress", resp.StatusCode(), want)
+		if want != http.StatusOK {
+			return models.LessonProgress{}
+		}
+		return apitest.Data[models.LessonProgress](t, resp.Body, "progress")
+	}
+
+	t.Run("only enrolled learners", func(t *testing.T) {
+		update(t, stranger, course.LessonID, api.UpdateLessonProgressJSONRequestBody{Percent: ptr(float32(10))}, http.StatusForbidden)
+		update(t, student, uuid.NewString(), api.UpdateLessonProgressJSONRequestBody{Percent: ptr(float32(10))}, http.StatusNotFound)
+	})
+
+	t.Run("partial progress", func(t *testing.T) {
+		p := update(t, student, course.LessonID, api.UpdateLessonProgressJSONRequestBody{Percent: ptr(float32(40)), LastWatchedSec: ptr(-5)}, http.StatusOK)
+		if p.Percent != 40 || p.LastWatchedSec != 0 || p.CompletedAt != nil {
+			t.Fatalf("partial progress: %+v", p)
+		}
+		if got, _ := userProgress(t, student, course.ID); got.Progress != 0 {
+			t.Fatalf("course progress %v, want 0", got.Progress)
+		}
+	})
+
+	t.Run("completed lesson stays completed", func(t *testing.T) {
+		p := update(t, student, course.LessonID, api.UpdateLessonProgressJSONRequestBody{Percent: ptr(float32(150))}, http.StatusOK)
+		if p.Percent != 100 || p.CompletedAt == nil {
+			t.Fatalf("completed progress: %+v", p)
+		}
+
+		p = update(t, student, course.LessonID, api.UpdateLessonProgressJSONRequestBody{Percent: ptr(float32(10))}, http.StatusOK)
+		if p.CompletedAt == nil {
+			t.Fatalf("lesson lost completion: %+v", p)
+		}
+
+		got, _ := userProgress(t, student, course.ID)
+		if got.Progress != 50 || got.Status != models.EnrollmentStatusActive {
+			t.Fatalf("course progress after one of two lessons: %+v", got)
+		}
+	})
+
+	t.Run("course is completed with the last lesson", func(t *testing.T) {
+		update(t, student, secondID, api.UpdateLessonProgressJSONRequestBody{Completed: ptr(true)}, http.StatusOK)
+
+		got, _ := userProgress(t, student, course.ID)
+		if got.Progress != 100 || got.Status != models.EnrollmentStatusCompleted {
+			t.Fatalf("course progress after all lessons: %+v", got)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// eventsChannel — канал Redis Pub/Sub, в который публикуются доменные события
+const eventsChannel = "handbooks:events"
+
+// Типы доменных событий
+const (
+	EventCoursePublished = "course.published"
+	EventLessonPublished = "lesson.published"
+)
+
+// Event — доменное событие; подписчики читают его из eventsChannel
+type Event struct {
+	ID         uuid.UUID      `json:"id"`
+	Type       string         `json:"type"`
+	OccurredAt time.Time      `json:"occurredAt"`
+	Data       map[string]any `json:"data"`
+}
+
+// emitEvent — публикует событие. Pub/Sub не гарантирует доставку, поэтому ошибка
+// только логируется и не влияет на уже выполненное действие
+func (s *Server) emitEvent(ctx context.Context, eventType string, data map[string]any) {
+	event := Event{
+		ID:         uuid.New(),
+		Type:       eventType,
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		slog.ErrorContext(ctx, "cannot marshal event", slog.String("type", eventType), slog.String("error", err.Error()))
+		return
+	}
+
+	if err := s.Redis.Publish(ctx, eventsChannel, payload).Err(); err != nil {
+		slog.ErrorContext(ctx, "cannot publish event", slog.String("type", eventType), slog.String("error", err.Error()))
+		return
+	}
+
+	slog.DebugContext(ctx, "event published", slog.String("type", eventType), slog.Any("data", data))
+}
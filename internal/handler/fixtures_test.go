@@ -0,0 +1,116 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"handbooks/internal/api"
+	"handbooks/internal/apitest"
+	"handbooks/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// testCourse — курс с одним разделом и одним опубликованным текстовым уроком, готовый к публикации
+type testCourse struct {
+	ID        string
+	SectionID string
+	LessonID  string
+}
+
+func ptr[T any](v T) *T { return &v }
+
+// createCourse — создаёт курс от имени s; withContent добавляет раздел и опубликованный урок
+func createCourse(t *testing.T, s *apitest.Session, withContent bool) testCourse {
+	t.Helper()
+	ctx := context.Background()
+
+	resp, err := s.Client.CreateCourseWithResponse(ctx, api.CreateCourseJSONRequestBody{
+		Title:       "Course " + uuid.NewString(),
+		Description: ptr("Описание курса"),
+		CoverUrl:    ptr("https://example.com/cover.png"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		t.Fatalf("create course: %d: %s", resp.StatusCode(), resp.Body)
+	}
+	course := testCourse{ID: apitest.Data[string](t, resp.Body, "course")}
+	if !withContent {
+		return course
+	}
+
+	section, err := s.Client.CreateSectionWithResponse(ctx, course.ID, api.CreateSectionJSONRequestBody{Title: "Введение"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if section.StatusCode() != http.StatusCreated {
+		t.Fatalf("create section: %d: %s", section.StatusCode(), section.Body)
+	}
+	course.SectionID = apitest.Data[models.Section](t, section.Body, "section").ID.String()
+
+	lesson, err := s.Client.CreateLessonWithResponse(ctx, course.ID, course.SectionID, api.CreateLessonJSONRequestBody{
+		Title:       "Первый урок",
+		Type:        api.LessonCreateTypeText,
+		Content:     ptr("# Первый урок"),
+		IsPublished: ptr(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lesson.StatusCode() != http.StatusCreated {
+		t.Fatalf("create lesson: %d: %s", lesson.StatusCode(), lesson.Body)
+	}
+	course.LessonID = apitest.Data[models.Lesson](t, lesson.Body, "lesson").ID.String()
+
+	return course
+}
+
+// transition — статус курса из ответа перехода; при неожиданном коде ответа тест падает
+func transition(t *testing.T, status int, body []byte, want int) string {
+	t.Helper()
+
+	if status != want {
+		t.Fatalf("expected %d, got %d: %s", want, status, body)
+	}
+	if status != http.StatusOK {
+		return ""
+	}
+
+	return apitest.Data[models.Course](t, body, "course").Status
+}
+
+// publishCourse — проводит курс через submit, approve и publish
+func publishCourse(t *testing.T, owner, admin *apitest.Session, courseID string) {
+	t.Helper()
+	ctx := context.Background()
+	none := api.CourseTransitionRequest{}
+
+	submit, err := owner.Client.SubmitCourseWithResponse(ctx, courseID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, submit.StatusCode(), submit.Body, http.StatusOK)
+
+	approve, err := admin.Client.ApproveCourseWithResponse(ctx, courseID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, approve.StatusCode(), approve.Body, http.StatusOK)
+
+	publish, err := owner.Client.PublishCourseWithResponse(ctx, courseID, none)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, publish.StatusCode(), publish.Body, http.StatusOK)
+}
+
+// expectStatus — проверка кода ответа с названием шага в сообщении
+func expectStatus(t *testing.T, name string, status, want int) {
+	t.Helper()
+	if status != want {
+		t.Fatalf("%s: expected %d, got %d", name, want, status)
+	}
+}
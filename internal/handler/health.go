@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"handbooks/internal/database"
+)
+
+const readinessCheckTimeout = 2 * time.Second
+
+// componentStatus — состояние одной зависимости в ответе /readyz
+type componentStatus struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Healthz — liveness: процесс жив и обслуживает запросы
+func (s *Server) Healthz(w http.ResponseWriter, r *http.Request) {
+	s.JSON(w, r, http.StatusOK, map[string]string{"status": "ok"}, "health")
+}
+
+// Readyz — readiness: Postgres, Redis и версия схемы; 503, пока сервер останавливается
+func (s *Server) Readyz(w http.ResponseWriter, r *http.Request) {
+	checks := map[string]func(ctx context.Context) error{
+		"postgres": func(ctx context.Context) error {
+			return s.DB.Ping(ctx)
+		},
+		"redis": func(ctx context.Context) error {
+			return s.Redis.Ping(ctx).Err()
+		},
+		"migrations": s.checkSchemaVersion,
+	}
+
+	var (
+		mu         sync.Mutex
+		wg         sync.WaitGroup
+		ready      = true
+		components = make(map[string]componentStatus, len(checks))
+	)
+
+	for name, check := range checks {
+		wg.Go(func() {
+			ctx, cancel := context.WithTimeout(r.Context(), readinessCheckTimeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check(ctx)
+			status := componentStatus{
+				Status:    "up",
+				LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+			}
+			if err != nil {
+				status.Status, status.Error = "down", err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			components[name] = status
+			ready = ready && err == nil
+		})
+	}
+	wg.Wait()
+
+	state, code := "ready", http.StatusOK
+	switch {
+	case s.draining.Load():
+		state, code = "draining", http.StatusServiceUnavailable
+	case !ready:
+		state, code = "not_ready", http.StatusServiceUnavailable
+	}
+
+	s.JSON(w, r, code, map[string]any{
+		"status":     state,
+		"components": components,
+	}, "health")
+}
+
+// checkSchemaVersion — схема БД не отстаёт от самой новой миграции в ./migrations
+func (s *Server) checkSchemaVersion(ctx context.Context) error {
+	expected, err := database.LatestMigrationVersion()
+	if err != nil {
+		return err
+	}
+
+	current, err := database.SchemaVersion(ctx, s.DB)
+	if err != nil {
+		return err
+	}
+
+	if current < expected {
+		return fmt.Errorf("schema version %d is behind %d", current, expected)
+	}
+
+	return nil
+}
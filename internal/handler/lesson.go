@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"handbooks/internal/api"
+	"handbooks/internal/markdown"
 	"handbooks/internal/models"
 	storage "handbooks/pkg/storage"
 	"log/slog"
@@ -10,7 +13,6 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/gosimple/slug"
 	"github.com/huandu/go-sqlbuilder"
 )
 
@@ -27,42 +29,184 @@ func (s *Server) CreateLesson(w http.ResponseWriter, r *http.Request, courseID s
 		return
 	}
 
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	claims := ctx.Value("user").(*Claims)
+	course, ok := s.lockCourseForEdit(w, r, tx, courseID, claims)
+	if !ok {
+		return
+	}
+
+	section, err := storage.GetOne[models.Section](ctx, tx, "sections", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", sectionID), sb.Equal("course_id", course.ID))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Section not found", "error")
+		return
+	}
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
 	time := time.Now()
 	lesson.ID = uuid.New()
-	lesson.Slug = slug.Make(lesson.Title)
-	lesson.CreatedID = ctx.Value("user").(*Claims).ID
+	lesson.SectionID = section.ID
+	lesson.CourseID = section.CourseID
+	lesson.CreatedID = claims.ID
 	lesson.CreatedAt = time
 	lesson.UpdatedAt = time
 
-	if err := storage.Create(ctx, "lessons", lesson, s.DB); err != nil {
+	estimateDuration(ctx, &lesson)
+
+	lesson.Slug, err = lessonSlugs.generate(ctx, tx, section.ID, lesson.Title, uuid.Nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error generating lesson slug", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	lesson.Order, err = reserveOrder(ctx, tx, "lessons", "section_id", section.ID, lesson.Order)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error reserving lesson order", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := storage.Create(ctx, "lessons", lesson, tx); err != nil {
 		slog.ErrorContext(ctx, "Error creating lesson", slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
+	if _, err := recordLessonRevision(ctx, tx, lesson.ID, lesson.Content, claims.ID, nil); err != nil {
+		slog.ErrorContext(ctx, "Error recording lesson revision", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
 	s.JSON(w, r, http.StatusCreated, lesson, "lesson")
 }
 
 // GetLessons implements [api.ServerInterface].
 func (s *Server) GetLessons(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, params api.GetLessonsParams) {
-	var ctx = r.Context()
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
 
-	lessons, err := storage.GetAll[models.Lesson](ctx, "lessons", s.DB)
+	course, err := storage.GetOne[models.Course](ctx, s.DB, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Course not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course by ID", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	access, err := loadCourseAccess(ctx, s.DB, course, claims)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error loading course access", slog.String("course_id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	// Черновики и запланированные уроки видит только команда курса, и то если попросит publishedOnly=false.
+	// Ученики читают опубликованную версию курса
+	publishedOnly := !access.manager || params.PublishedOnly == nil || *params.PublishedOnly
+
+	lessons, err := storage.GetAll[models.Lesson](ctx, access.content.lessons(), s.DB, func(sb *sqlbuilder.SelectBuilder) {
+		access.content.scope(sb)
+		sb.Where(sb.Equal("section_id", sectionID), sb.Equal("course_id", courseID))
+		if publishedOnly {
+			sb.Where(lessonVisibleCond)
+		}
+		sb.OrderBy(`"order"`)
+	})
 	if err != nil {
 		slog.ErrorContext(ctx, "Error getting lessons", slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
-	s.JSON(w, r, http.StatusOK, lessons, "lessons")
+	views := make([]lessonView, 0, len(lessons))
+	for _, lesson := range lessons {
+		lock := access.lessonLock(lesson)
+		if lock.Locked {
+			// Закрытый урок виден в списке, но без содержимого
+			lesson.Content = ""
+		}
+		views = append(views, newLessonView(ctx, lesson, lock))
+	}
+
+	s.JSON(w, r, http.StatusOK, views, "lessons")
+}
+
+// lessonView — урок в ответе API вместе с доступностью для текущего пользователя.
+// У текстового урока Content — исходный Markdown, ContentHTML — безопасный HTML для показа
+type lessonView struct {
+	models.Lesson
+	lessonLock
+	ContentHTML string             `json:"contentHtml,omitempty"`
+	TOC         []markdown.Heading `json:"toc,omitempty"`
+}
+
+func newLessonView(ctx context.Context, lesson models.Lesson, lock lessonLock) lessonView {
+	view := lessonView{Lesson: lesson, lessonLock: lock}
+	if lesson.Type != models.LessonTypeText || lesson.Content == "" {
+		return view
+	}
+
+	doc, err := markdown.Render(lesson.Content)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error rendering lesson markdown", slog.String("lesson_id", lesson.ID.String()), slog.String("error", err.Error()))
+		return view
+	}
+	view.ContentHTML = doc.HTML
+	view.TOC = doc.TOC
+	if view.DurationSec == 0 {
+		view.DurationSec = doc.ReadingTimeSec
+	}
+	return view
+}
+
+// estimateDuration — для текстового урока без длительности подставляет время чтения
+func estimateDuration(ctx context.Context, lesson *models.Lesson) {
+	if lesson.Type != models.LessonTypeText || lesson.DurationSec != 0 || lesson.Content == "" {
+		return
+	}
+	doc, err := markdown.Render(lesson.Content)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error rendering lesson markdown", slog.String("error", err.Error()))
+		return
+	}
+	lesson.DurationSec = doc.ReadingTimeSec
 }
 
 // DeleteLesson implements [api.ServerInterface].
 func (s *Server) DeleteLesson(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string) {
 	var ctx = r.Context()
 
+	if _, ok := s.loadManagedLesson(w, r, s.DB, courseID, sectionID, lessonID, false); !ok {
+		return
+	}
+
 	if err := storage.Delete[models.Lesson](ctx, "lessons", s.DB, func(sb *sqlbuilder.SelectBuilder) {
-		sb.Where(sb.Equal("id", lessonID))
+		sb.Where(sb.Equal("id", lessonID), sb.Equal("section_id", sectionID), sb.Equal("course_id", courseID))
 	}); err != nil {
 		slog.ErrorContext(ctx, "Error deleting lesson by ID", slog.String("error", err.Error()), slog.Any("ID", lessonID))
 		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
@@ -74,37 +218,129 @@ func (s *Server) DeleteLesson(w http.ResponseWriter, r *http.Request, courseID s
 
 // GetLessonByID implements [api.ServerInterface].
 func (s *Server) GetLessonByID(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string) {
-	ctx := r.Context()
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
 
-	course, err := storage.GetOne[models.Lesson](ctx, s.DB, "lessons", func(sb *sqlbuilder.SelectBuilder) {
-		sb.Where(sb.Equal("id", lessonID))
+	course, err := storage.GetOne[models.Course](ctx, s.DB, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID))
 	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Lesson not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course by ID", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.serveLesson(w, r, course, lessonID, sectionID, claims)
+}
 
+// serveLesson — отдаёт урок с проверкой доступа: черновик — 404, закрытый урок — 403 с причиной.
+// Ученики получают урок из опубликованной версии курса. sectionID == "" — раздел не проверяется
+func (s *Server) serveLesson(w http.ResponseWriter, r *http.Request, course *models.Course, lessonID, sectionID string, claims *Claims) {
+	ctx := r.Context()
+
+	access, err := loadCourseAccess(ctx, s.DB, course, claims)
 	if err != nil {
-		slog.ErrorContext(ctx, "Error getting course by id",
+		slog.ErrorContext(ctx, "Error loading course access", slog.String("course_id", course.ID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	lesson, err := storage.GetOne[models.Lesson](ctx, s.DB, access.content.lessons(), func(sb *sqlbuilder.SelectBuilder) {
+		access.content.scope(sb)
+		sb.Where(sb.Equal("id", lessonID), sb.Equal("course_id", course.ID))
+		if sectionID != "" {
+			sb.Where(sb.Equal("section_id", sectionID))
+		}
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Lesson not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting lesson by id",
 			slog.String("id", lessonID),
 			slog.String("error", err.Error()))
-		s.JSON(w, r, http.StatusInternalServerError, nil, "internal server error")
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
-	s.JSON(w, r, http.StatusOK, course, "course")
+	// Черновик или ещё не вышедший урок для учеников как будто не существует
+	if !access.manager && !lessonVisible(*lesson, time.Now()) {
+		s.JSON(w, r, http.StatusNotFound, "Lesson not found", "error")
+		return
+	}
+
+	if lock := access.lessonLock(*lesson); lock.Locked {
+		s.JSON(w, r, http.StatusForbidden, lock, "lock")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, newLessonView(ctx, *lesson, lessonLock{}), "lesson")
 }
 
 // UpdateLesson implements [api.ServerInterface].
 func (s *Server) UpdateLesson(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string) {
 	var (
-		ctx    = r.Context()
-		lesson models.Lesson
+		ctx  = r.Context()
+		body json.RawMessage
 	)
 
-	if err := json.NewDecoder(r.Body).Decode(&lesson); err != nil {
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		slog.ErrorContext(ctx, "Error decoding request body", slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
 		return
 	}
 
-	if err := storage.Update[models.Lesson](ctx, "lessons", lesson, s.DB, func(sb *sqlbuilder.UpdateBuilder) {
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	current, ok := s.loadManagedLesson(w, r, tx, courseID, sectionID, lessonID, true)
+	if !ok {
+		return
+	}
+
+	// PATCH: поля, которых нет в запросе, остаются прежними
+	lesson := *current
+	if err := json.Unmarshal(body, &lesson); err != nil {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+	lesson.ID, lesson.SectionID, lesson.CourseID, lesson.CreatedID = current.ID, current.SectionID, current.CourseID, current.CreatedID
+	lesson.Order, lesson.CreatedAt = current.Order, current.CreatedAt
+	lesson.UpdatedAt = time.Now()
+
+	// Оценка прежнего текста к новому не подходит, если длительность не задали явно
+	if lesson.Content != current.Content && lesson.DurationSec == current.DurationSec {
+		lesson.DurationSec = 0
+	}
+	estimateDuration(ctx, &lesson)
+
+	// slug следует за названием; прежний остаётся в истории и отвечает редиректом
+	lesson.Slug = current.Slug
+	if lesson.Title != current.Title {
+		lesson.Slug, err = lessonSlugs.generate(ctx, tx, current.SectionID, lesson.Title, current.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "Error generating lesson slug", slog.String("error", err.Error()))
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+	}
+	if err := lessonSlugs.rename(ctx, tx, current.SectionID, current.ID, current.Slug, lesson.Slug); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := storage.Update[models.Lesson](ctx, "lessons", lesson, tx, func(sb *sqlbuilder.UpdateBuilder) {
 		sb.Where(sb.Equal("id", lessonID))
 	}); err != nil {
 		slog.ErrorContext(ctx, "Error updating lesson", slog.String("error", err.Error()))
@@ -112,7 +348,21 @@ func (s *Server) UpdateLesson(w http.ResponseWriter, r *http.Request, courseID s
 		return
 	}
 
-	s.JSON(w, r, http.StatusCreated, lesson, "lesson_id")
+	// Прежнее содержимое остаётся в истории ревизий
+	if lesson.Content != current.Content {
+		if _, err := recordLessonRevision(ctx, tx, current.ID, lesson.Content, ctx.Value("user").(*Claims).ID, nil); err != nil {
+			slog.ErrorContext(ctx, "Error recording lesson revision", slog.String("error", err.Error()))
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, lesson, "lesson")
 }
 
 // ProgressUpdateLessonProgress implements [api.ServerInterface].
@@ -0,0 +1,264 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"handbooks/internal/api"
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Каждое изменение содержимого урока сохраняется новой ревизией. Ревизии не изменяются
+// (UPDATE запрещён триггером, кроме обнуления автора при его удалении), откат к старой
+// ревизии тоже создаёт новую.
+
+// lessonRevisionDiff — разница содержимого между двумя ревизиями
+type lessonRevisionDiff struct {
+	From    int    `json:"from"`
+	To      int    `json:"to"`
+	Added   int    `json:"added"`
+	Removed int    `json:"removed"`
+	Diff    string `json:"diff"`
+}
+
+// GetLessonRevisions implements [api.ServerInterface].
+func (s *Server) GetLessonRevisions(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string) {
+	ctx := r.Context()
+
+	lesson, ok := s.loadManagedLesson(w, r, s.DB, courseID, sectionID, lessonID, false)
+	if !ok {
+		return
+	}
+
+	revisions, err := storage.GetAll[models.LessonRevision](ctx, "lesson_revisions", s.DB, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("lesson_id", lesson.ID)).OrderByDesc("number")
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting lesson revisions", slog.String("lesson_id", lessonID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, revisions, "revisions")
+}
+
+// DiffLessonRevisions implements [api.ServerInterface].
+func (s *Server) DiffLessonRevisions(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string, params api.DiffLessonRevisionsParams) {
+	ctx := r.Context()
+
+	lesson, ok := s.loadManagedLesson(w, r, s.DB, courseID, sectionID, lessonID, false)
+	if !ok {
+		return
+	}
+
+	revisions, err := storage.GetAll[models.LessonRevision](ctx, "lesson_revisions", s.DB, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("lesson_id", lesson.ID), sb.In("number", params.From, params.To))
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting lesson revisions", slog.String("lesson_id", lessonID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	byNumber := make(map[int]models.LessonRevision, len(revisions))
+	for _, revision := range revisions {
+		byNumber[revision.Number] = revision
+	}
+	from, okFrom := byNumber[params.From]
+	to, okTo := byNumber[params.To]
+	if !okFrom || !okTo {
+		s.JSON(w, r, http.StatusNotFound, "Revision not found", "error")
+		return
+	}
+
+	diff, err := diffRevisions(from, to)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error diffing lesson revisions", slog.String("lesson_id", lessonID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, diff, "diff")
+}
+
+// RestoreLessonRevision implements [api.ServerInterface].
+func (s *Server) RestoreLessonRevision(w http.ResponseWriter, r *http.Request, courseID string, sectionID string, lessonID string, revision int) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	lesson, ok := s.loadManagedLesson(w, r, tx, courseID, sectionID, lessonID, true)
+	if !ok {
+		return
+	}
+
+	restored, err := storage.GetOne[models.LessonRevision](ctx, tx, "lesson_revisions", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("lesson_id", lesson.ID), sb.Equal("number", revision))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Revision not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting lesson revision", slog.String("lesson_id", lessonID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	lesson.Content = restored.Content
+	lesson.UpdatedAt = time.Now()
+	if err := storage.UpdateFields(ctx, "lessons", map[string]any{
+		"content":    lesson.Content,
+		"updated_at": lesson.UpdatedAt,
+	}, tx, func(ub *sqlbuilder.UpdateBuilder) {
+		ub.Where(ub.Equal("id", lesson.ID))
+	}); err != nil {
+		slog.ErrorContext(ctx, "Error restoring lesson revision", slog.String("lesson_id", lessonID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if _, err := recordLessonRevision(ctx, tx, lesson.ID, lesson.Content, claims.ID, &restored.Number); err != nil {
+		slog.ErrorContext(ctx, "Error recording lesson revision", slog.String("lesson_id", lessonID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, lesson, "lesson")
+}
+
+// loadManagedLesson — урок курса, который пользователь может редактировать. forUpdate блокирует
+// строку урока до конца транзакции, чтобы номера ревизий шли по порядку. При ошибке сам пишет ответ
+func (s *Server) loadManagedLesson(w http.ResponseWriter, r *http.Request, db storage.Querier, courseID, sectionID, lessonID string, forUpdate bool) (*models.Lesson, bool) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	course, err := storage.GetOne[models.Course](ctx, db, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Lesson not found", "error")
+		return nil, false
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course by ID", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return nil, false
+	}
+
+	allowed, err := canManageCourse(ctx, db, course, claims)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return nil, false
+	}
+	if !allowed {
+		s.JSON(w, r, http.StatusForbidden, "Forbidden", "error")
+		return nil, false
+	}
+
+	lesson, err := storage.GetOne[models.Lesson](ctx, db, "lessons", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", lessonID), sb.Equal("section_id", sectionID), sb.Equal("course_id", courseID))
+		if forUpdate {
+			sb.ForUpdate()
+		}
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Lesson not found", "error")
+		return nil, false
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting lesson by id", slog.String("id", lessonID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return nil, false
+	}
+
+	return lesson, true
+}
+
+// recordLessonRevision — сохраняет содержимое урока следующей по номеру ревизией.
+// Вызывать для нового урока или под блокировкой строки урока
+func recordLessonRevision(ctx context.Context, tx pgx.Tx, lessonID uuid.UUID, content string, authorID uuid.UUID, restoredFrom *int) (models.LessonRevision, error) {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("COALESCE(MAX(number), 0)").From("lesson_revisions").Where(sb.Equal("lesson_id", lessonID))
+	query, args := sb.Build()
+
+	var last int
+	if err := tx.QueryRow(ctx, query, args...).Scan(&last); err != nil {
+		return models.LessonRevision{}, err
+	}
+
+	revision := models.LessonRevision{
+		ID:           uuid.New(),
+		LessonID:     lessonID,
+		Number:       last + 1,
+		Content:      content,
+		RestoredFrom: restoredFrom,
+		CreatedAt:    time.Now(),
+	}
+	if authorID != uuid.Nil {
+		revision.AuthorID = &authorID
+	}
+
+	if err := storage.Create(ctx, "lesson_revisions", revision, tx); err != nil {
+		return models.LessonRevision{}, err
+	}
+	return revision, nil
+}
+
+// diffRevisions — построчная разница содержимого в формате unified diff
+func diffRevisions(from, to models.LessonRevision) (lessonRevisionDiff, error) {
+	a := difflib.SplitLines(from.Content)
+	b := difflib.SplitLines(to.Content)
+
+	diff := lessonRevisionDiff{From: from.Number, To: to.Number}
+	for _, op := range difflib.NewMatcher(a, b).GetOpCodes() {
+		switch op.Tag {
+		case 'r':
+			diff.Removed += op.I2 - op.I1
+			diff.Added += op.J2 - op.J1
+		case 'd':
+			diff.Removed += op.I2 - op.I1
+		case 'i':
+			diff.Added += op.J2 - op.J1
+		}
+	}
+
+	text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        a,
+		B:        b,
+		FromFile: "revision " + strconv.Itoa(from.Number),
+		ToFile:   "revision " + strconv.Itoa(to.Number),
+		Context:  3,
+	})
+	if err != nil {
+		return lessonRevisionDiff{}, err
+	}
+	diff.Diff = text
+
+	return diff, nil
+}
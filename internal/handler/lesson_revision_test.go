@@ -0,0 +1,180 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"handbooks/internal/api"
+	"handbooks/internal/apitest"
+	"handbooks/internal/models"
+)
+
+// editLesson — меняет содержимое урока курса, созданного createCourse
+func editLesson(t *testing.T, s *apitest.Session, course testCourse, content string) {
+	t.Helper()
+
+	resp, err := s.Client.UpdateLessonWithResponse(context.Background(), course.ID, course.SectionID, course.LessonID, api.UpdateLessonJSONRequestBody{
+		Title:       ptr("Первый урок"),
+		Type:        ptr(api.Text),
+		Content:     ptr(content),
+		IsPublished: ptr(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() >= http.StatusMultipleChoices {
+		t.Fatalf("update lesson: %d: %s", resp.StatusCode(), resp.Body)
+	}
+}
+
+func TestLessonRevisions(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	student := h.NewUser(models.RoleStudent)
+	course := createCourse(t, owner, true)
+
+	editLesson(t, owner, course, "# Первый урок\n\nВторая строка")
+	editLesson(t, owner, course, "# Первый урок\n\nВторая строка\nТретья строка")
+
+	revisions := func() []models.LessonRevision {
+		t.Helper()
+		resp, err := owner.Client.GetLessonRevisionsWithResponse(ctx, course.ID, course.SectionID, course.LessonID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "revisions", resp.StatusCode(), http.StatusOK)
+		return apitest.Data[[]models.LessonRevision](t, resp.Body, "revisions")
+	}
+
+	t.Run("every content change is a revision", func(t *testing.T) {
+		list := revisions()
+		if len(list) != 3 || list[0].Number != 3 || list[2].Number != 1 {
+			t.Fatalf("expected revisions 3..1, got %+v", list)
+		}
+		if list[2].Content != "# Первый урок" || list[2].AuthorID == nil || *list[2].AuthorID != owner.User().ID {
+			t.Fatalf("unexpected first revision: %+v", list[2])
+		}
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		resp, err := owner.Client.DiffLessonRevisionsWithResponse(ctx, course.ID, course.SectionID, course.LessonID, &api.DiffLessonRevisionsParams{From: 1, To: 3})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "diff", resp.StatusCode(), http.StatusOK)
+
+		diff := apitest.Data[api.LessonRevisionDiff](t, resp.Body, "diff")
+		if diff.Added == nil || *diff.Added != 3 || diff.Removed == nil || *diff.Removed != 0 {
+			t.Fatalf("unexpected diff: %s", resp.Body)
+		}
+
+		missing, err := owner.Client.DiffLessonRevisionsWithResponse(ctx, course.ID, course.SectionID, course.LessonID, &api.DiffLessonRevisionsParams{From: 1, To: 42})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "diff with unknown revision", missing.StatusCode(), http.StatusNotFound)
+	})
+
+	t.Run("restore creates a new revision", func(t *testing.T) {
+		resp, err := owner.Client.RestoreLessonRevisionWithResponse(ctx, course.ID, course.SectionID, course.LessonID, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "restore", resp.StatusCode(), http.StatusOK)
+		if content := apitest.Data[models.Lesson](t, resp.Body, "lesson").Content; content != "# Первый урок" {
+			t.Fatalf("restored content: %q", content)
+		}
+
+		list := revisions()
+		if len(list) != 4 || list[0].RestoredFrom == nil || *list[0].RestoredFrom != 1 {
+			t.Fatalf("expected revision 4 restored from 1, got %+v", list[0])
+		}
+
+		missing, err := owner.Client.RestoreLessonRevisionWithResponse(ctx, course.ID, course.SectionID, course.LessonID, 42)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "restore unknown revision", missing.StatusCode(), http.StatusNotFound)
+	})
+
+	t.Run("only managers see revisions", func(t *testing.T) {
+		resp, err := student.Client.GetLessonRevisionsWithResponse(ctx, course.ID, course.SectionID, course.LessonID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "student revisions", resp.StatusCode(), http.StatusForbidden)
+
+		restore, err := student.Client.RestoreLessonRevisionWithResponse(ctx, course.ID, course.SectionID, course.LessonID, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "student restore", restore.StatusCode(), http.StatusForbidden)
+	})
+
+	t.Run("revisions are immutable", func(t *testing.T) {
+		if _, err := h.DB.Exec(ctx, "UPDATE lesson_revisions SET content = 'forged' WHERE lesson_id = $1", course.LessonID); err == nil {
+			t.Fatal("revision content was updated")
+		}
+	})
+}
+
+func TestDeleteLessonEditor(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	course := createCourse(t, owner, true)
+	editLesson(t, owner, course, "# Первый урок\n\nПравка автора")
+
+	// Удаление автора обнуляет author_id в ревизиях, а не упирается в триггер неизменяемости
+	resp, err := owner.Client.DeleteCurrentUserWithResponse(ctx, api.DeleteCurrentUserJSONRequestBody{Password: ptr(apitest.DefaultPassword)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("delete editor: %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	var total, detached int
+	if err := h.DB.QueryRow(ctx,
+		"SELECT COUNT(*), COUNT(*) FILTER (WHERE author_id IS NULL) FROM lesson_revisions WHERE lesson_id = $1",
+		course.LessonID,
+	).Scan(&total, &detached); err != nil {
+		t.Fatal(err)
+	}
+	if total != 2 || detached != 2 {
+		t.Fatalf("expected 2 revisions without author, got %d of %d", detached, total)
+	}
+}
+
+func TestUpdateLessonKeepsOmittedFields(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	course := createCourse(t, owner, true)
+
+	resp, err := owner.Client.UpdateLessonWithResponse(ctx, course.ID, course.SectionID, course.LessonID, api.UpdateLessonJSONRequestBody{Title: ptr("Новое название")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "update title", resp.StatusCode(), http.StatusOK)
+
+	lesson := apitest.Data[models.Lesson](t, resp.Body, "lesson")
+	if lesson.Title != "Новое название" || lesson.Content != "# Первый урок" || lesson.Type != models.LessonTypeText || !lesson.IsPublished {
+		t.Fatalf("omitted fields changed: %+v", lesson)
+	}
+
+	// Содержимое не менялось — новой ревизии нет
+	revisions, err := owner.Client.GetLessonRevisionsWithResponse(ctx, course.ID, course.SectionID, course.LessonID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "revisions", revisions.StatusCode(), http.StatusOK)
+	if list := apitest.Data[[]models.LessonRevision](t, revisions.Body, "revisions"); len(list) != 1 {
+		t.Fatalf("expected a single revision, got %d", len(list))
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Защита входа от перебора паролей и кодов 2FA. Неудачные попытки считаются в Redis отдельно
+// для аккаунта (email), для IP и для второго фактора пользователя. После maxLoginAttempts неудач
+// субъект блокируется на lockoutBase, каждая следующая неудача удваивает блокировку вплоть до lockoutMax.
+
+// loginSubjects — ключи субъектов, по которым считаются попытки входа по паролю
+func loginSubjects(email, ip string) []string {
+	return []string{emailSubject(email), "ip:" + ip}
+}
+
+// emailSubject — субъект попыток входа в аккаунт; email сравнивается без учёта регистра
+func emailSubject(email string) string {
+	return "email:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// mfaSubject — субъект неудачных кодов 2FA. Считается по пользователю, а не по challenge,
+// и не сбрасывается верным паролем: иначе код можно перебирать, каждый раз входя заново
+func mfaSubject(userID uuid.UUID) string {
+	return "mfa:" + userID.String()
+}
+
+// loginLockRemaining — сколько ещё действует самая долгая из блокировок субъектов
+func (s *Server) loginLockRemaining(ctx context.Context, subjects []string) (time.Duration, error) {
+	var remaining time.Duration
+
+	for _, subject := range subjects {
+		ttl, err := s.Redis.PTTL(ctx, "login_lock:"+subject).Result()
+		if err != nil {
+			return 0, err
+		}
+		if ttl > remaining {
+			remaining = ttl
+		}
+	}
+
+	return remaining, nil
+}
+
+// registerLoginFailure — учитывает неудачную попытку и при необходимости блокирует вход.
+// Возвращает длительность блокировки, если она была установлена.
+func (s *Server) registerLoginFailure(ctx context.Context, subjects []string) (time.Duration, error) {
+	var locked time.Duration
+
+	for _, subject := range subjects {
+		failKey := "login_fail:" + subject
+
+		pipe := s.Redis.TxPipeline()
+		incr := pipe.Incr(ctx, failKey)
+		pipe.Expire(ctx, failKey, s.Config().Auth.LoginWindowDur)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return 0, err
+		}
+
+		over := incr.Val() - int64(s.Config().Auth.MaxLoginAttempts)
+		if over < 0 {
+			continue
+		}
+
+		lock := s.lockoutDuration(over)
+		if err := s.Redis.Set(ctx, "login_lock:"+subject, "locked", lock).Err(); err != nil {
+			return 0, err
+		}
+		if lock > locked {
+			locked = lock
+		}
+	}
+
+	return locked, nil
+}
+
+// resetLoginFailures — сбрасывает счётчик субъекта после успешного входа.
+// Счётчик IP не сбрасывается, иначе его можно обнулять входом в свой аккаунт.
+func (s *Server) resetLoginFailures(ctx context.Context, subject string) error {
+	return s.Redis.Del(ctx, "login_fail:"+subject, "login_lock:"+subject).Err()
+}
+
+// lockoutDuration — экспоненциальная блокировка: lockoutBase * 2^over, не больше lockoutMax
+func (s *Server) lockoutDuration(over int64) time.Duration {
+	lock := s.Config().Auth.LockoutBaseDur
+	for range over {
+		lock *= 2
+		if lock >= s.Config().Auth.LockoutMaxDur {
+			return s.Config().Auth.LockoutMaxDur
+		}
+	}
+	return min(lock, s.Config().Auth.LockoutMaxDur)
+}
+
+// tooManyLoginAttempts — ответ 429 с заголовком Retry-After
+func (s *Server) tooManyLoginAttempts(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	seconds := int((retryAfter + time.Second - 1) / time.Second)
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	s.JSON(w, r, http.StatusTooManyRequests, "Слишком много попыток входа, попробуйте позже", "error")
+}
+
+// clientIP — IP клиента из RemoteAddr
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,377 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"image/png"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"handbooks/internal/api"
+	"handbooks/internal/metrics"
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/pquerna/otp/totp"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	recoveryCodesCount = 10
+	maxMfaAttempts     = 5
+)
+
+// EnrollTotp implements [api.ServerInterface].
+// Генерирует новый секрет; 2FA включится только после подтверждения кодом в VerifyTotp.
+func (s *Server) EnrollTotp(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	user, ok := s.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	if user.TotpEnabled {
+		s.JSON(w, r, http.StatusConflict, "2FA already enabled", "error")
+		return
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      s.Config().Auth.TotpIssuer,
+		AccountName: user.Email,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "totp generate failed", "err", err)
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+
+	if err := storage.UpdateFields(ctx, "users", map[string]any{"totp_secret": key.Secret()}, s.DB, func(ub *sqlbuilder.UpdateBuilder) {
+		ub.Where(ub.Equal("id", user.ID))
+	}); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	resp := map[string]any{
+		"secret":          key.Secret(),
+		"provisioningUri": key.URL(),
+	}
+
+	if img, err := key.Image(256, 256); err == nil {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err == nil {
+			resp["qrPng"] = "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())
+		}
+	}
+
+	s.JSON(w, r, http.StatusOK, resp, "totp")
+}
+
+// VerifyTotp implements [api.ServerInterface].
+// Подтверждает секрет первым кодом, включает 2FA и один раз показывает коды восстановления.
+func (s *Server) VerifyTotp(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req api.TotpCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	user, ok := s.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	if user.TotpEnabled || user.TotpSecret == "" {
+		s.JSON(w, r, http.StatusBadRequest, "2FA enrollment not started", "error")
+		return
+	}
+
+	if !totp.Validate(strings.TrimSpace(req.Code), user.TotpSecret) {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid code", "error")
+		return
+	}
+
+	codes, err := s.replaceRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error enabling totp", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, map[string]any{
+		"enabled":       true,
+		"recoveryCodes": codes,
+	}, "totp")
+}
+
+// DisableTotp implements [api.ServerInterface].
+func (s *Server) DisableTotp(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req api.TotpCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	user, ok := s.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	if !user.TotpEnabled {
+		s.JSON(w, r, http.StatusBadRequest, "2FA is not enabled", "error")
+		return
+	}
+
+	valid, err := s.checkSecondFactor(ctx, user, req.Code)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	if !valid {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid code", "error")
+		return
+	}
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	if err := storage.UpdateFields(ctx, "users", map[string]any{"totp_secret": "", "totp_enabled": false}, tx, func(ub *sqlbuilder.UpdateBuilder) {
+		ub.Where(ub.Equal("id", user.ID))
+	}); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := storage.Delete[models.RecoveryCode](ctx, "user_recovery_codes", tx, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("user_id", user.ID))
+	}); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, true, "totp")
+}
+
+// AuthVerifyMfa implements [api.ServerInterface].
+// Второй шаг входа: обменивает challenge-токен и код на обычные токены.
+func (s *Server) AuthVerifyMfa(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req api.MfaVerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	challengeKey := "mfa_challenge:" + req.ChallengeToken
+	userID, err := s.Redis.Get(ctx, challengeKey).Result()
+	if errors.Is(err, redis.Nil) {
+		s.JSON(w, r, http.StatusUnauthorized, "Challenge expired", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "redis get mfa challenge failed", "err", err)
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+
+	user, err := storage.GetOne[models.User](ctx, s.DB, "users", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", userID))
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "user not found for mfa", "user_id", userID, "err", err)
+		s.JSON(w, r, http.StatusUnauthorized, "User not found", "error")
+		return
+	}
+
+	// Блокировка по пользователю переживает новые challenge, которые выдаёт каждый верный пароль
+	subjects := []string{mfaSubject(user.ID)}
+	remaining, err := s.loginLockRemaining(ctx, subjects)
+	if err != nil {
+		slog.ErrorContext(ctx, "redis mfa lock check failed", "err", err)
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+	if remaining > 0 {
+		s.tooManyLoginAttempts(w, r, remaining)
+		return
+	}
+
+	valid, err := s.checkSecondFactor(ctx, user, req.Code)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+	if !valid {
+		// Ограничиваем число попыток на один challenge, чтобы код нельзя было перебрать
+		attemptsKey := "mfa_attempts:" + req.ChallengeToken
+		attempts, _ := s.Redis.Incr(ctx, attemptsKey).Result()
+		s.Redis.Expire(ctx, attemptsKey, s.Config().Auth.MfaChallengeDur)
+		if attempts >= maxMfaAttempts {
+			s.Redis.Del(ctx, challengeKey, attemptsKey)
+		}
+		if s.loginFailed(w, r, subjects) {
+			s.Redis.Del(ctx, challengeKey, attemptsKey)
+			return
+		}
+		s.JSON(w, r, http.StatusUnauthorized, "Invalid code", "error")
+		return
+	}
+
+	s.Redis.Del(ctx, challengeKey, "mfa_attempts:"+req.ChallengeToken)
+	if err := s.resetLoginFailures(ctx, mfaSubject(user.ID)); err != nil {
+		slog.ErrorContext(ctx, "redis reset mfa failures failed", "err", err)
+	}
+
+	metrics.Logins.WithLabelValues("mfa").Inc()
+	s.touchLastLogin(ctx, user)
+	s.issueTokens(w, r, user)
+}
+
+// issueMfaChallenge — вместо токенов выдаёт короткоживущий challenge-токен для второго шага входа
+func (s *Server) issueMfaChallenge(w http.ResponseWriter, r *http.Request, user *models.User) {
+	token, err := randomHex(32)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Token generation error", "error")
+		return
+	}
+
+	if err := s.Redis.Set(r.Context(), "mfa_challenge:"+token, user.ID.String(), s.Config().Auth.MfaChallengeDur).Err(); err != nil {
+		slog.ErrorContext(r.Context(), "redis set mfa challenge failed", "err", err)
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, map[string]any{
+		"mfa_required":    true,
+		"challenge_token": token,
+		"expires_in":      int(s.Config().Auth.MfaChallengeDur.Seconds()),
+	}, "auth")
+}
+
+// checkSecondFactor — проверяет TOTP-код (один раз на окно) или тратит код восстановления
+func (s *Server) checkSecondFactor(ctx context.Context, user *models.User, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if code == "" || user.TotpSecret == "" {
+		return false, nil
+	}
+
+	if totp.Validate(code, user.TotpSecret) {
+		// Защита от повторного использования кода в пределах его окна
+		fresh, err := s.Redis.SetNX(ctx, "totp_used:"+user.ID.String()+":"+code, 1, 90*time.Second).Result()
+		if err != nil {
+			return false, err
+		}
+		return fresh, nil
+	}
+
+	ub := sqlbuilder.PostgreSQL.NewUpdateBuilder()
+	ub.Update("user_recovery_codes").
+		Set(ub.Assign("used_at", time.Now())).
+		Where(ub.Equal("user_id", user.ID), ub.Equal("code_hash", hashRecoveryCode(code)), ub.IsNull("used_at"))
+	query, args := ub.Build()
+
+	tag, err := s.DB.Exec(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "cannot use recovery code", slog.String("error", err.Error()))
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// replaceRecoveryCodes — включает 2FA и заменяет коды восстановления новыми.
+// Возвращает коды в открытом виде, в базе хранятся только их хэши.
+func (s *Server) replaceRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := storage.UpdateFields(ctx, "users", map[string]any{"totp_enabled": true}, tx, func(ub *sqlbuilder.UpdateBuilder) {
+		ub.Where(ub.Equal("id", userID))
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := storage.Delete[models.RecoveryCode](ctx, "user_recovery_codes", tx, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("user_id", userID))
+	}); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	codes := make([]string, 0, recoveryCodesCount)
+	for range recoveryCodesCount {
+		raw, err := randomHex(5)
+		if err != nil {
+			return nil, err
+		}
+		code := raw[:5] + "-" + raw[5:]
+
+		if err := storage.Create(ctx, "user_recovery_codes", models.RecoveryCode{
+			ID:        uuid.New(),
+			UserID:    userID,
+			CodeHash:  hashRecoveryCode(code),
+			CreatedAt: now,
+		}, tx); err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+
+	return codes, tx.Commit(ctx)
+}
+
+// currentUser — загружает пользователя из Claims текущего запроса; при ошибке сам пишет ответ
+func (s *Server) currentUser(w http.ResponseWriter, r *http.Request) (*models.User, bool) {
+	ctx := r.Context()
+	claimsValue := ctx.Value("user")
+
+	claims, ok := claimsValue.(*Claims)
+	if !ok {
+		slog.ErrorContext(ctx, "Error parsing claims", slog.Any("Claims", claimsValue))
+		s.JSON(w, r, http.StatusInternalServerError, nil, "internal server error")
+		return nil, false
+	}
+
+	user, err := storage.GetOne[models.User](ctx, s.DB, "users", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", claims.ID))
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting user by ID", slog.Any("ID", claims.ID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, nil, "internal server error")
+		return nil, false
+	}
+
+	return user, true
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
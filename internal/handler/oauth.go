@@ -0,0 +1,448 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"handbooks/internal/api"
+	"handbooks/internal/config"
+	"handbooks/internal/metrics"
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/oauth2"
+)
+
+var (
+	errOAuthProviderNotFound = errors.New("oauth provider not configured")
+	errOAuthEmailRequired    = errors.New("provider did not return a verified email")
+	errOAuthEmailTaken       = errors.New("email already registered, log in and link the account")
+	errOAuthNotLinked        = errors.New("identity is not linked to any account")
+)
+
+// oauthIntentReauth — повторный вход уже привязанной личностью: вместо токенов выдаётся
+// одноразовый reauth-токен, которым подтверждается удаление аккаунта без пароля
+const oauthIntentReauth = "reauth"
+
+// oauthClient — настроенный провайдер: конфиг OAuth2 и, для OIDC, верификатор ID-токена
+type oauthClient struct {
+	cfg      config.OAuthProvider
+	oauth2   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+// oauthState — данные, которые живут в Redis между редиректом и callback
+type oauthState struct {
+	Provider string `json:"provider"`
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+	Intent   string `json:"intent,omitempty"`
+}
+
+// externalProfile — профиль пользователя у внешнего провайдера
+type externalProfile struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	AvatarURL     string
+}
+
+// AuthOAuthStart implements [api.ServerInterface].
+func (s *Server) AuthOAuthStart(w http.ResponseWriter, r *http.Request, provider string, params api.AuthOAuthStartParams) {
+	ctx := r.Context()
+
+	client, err := s.oauthClient(provider)
+	if err != nil {
+		s.oauthError(w, r, provider, err)
+		return
+	}
+
+	state, err := randomHex(16)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+	nonce, err := randomHex(16)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+
+	st := oauthState{Provider: provider, Verifier: oauth2.GenerateVerifier(), Nonce: nonce}
+	if params.Intent != nil && *params.Intent == oauthIntentReauth {
+		st.Intent = oauthIntentReauth
+	}
+	payload, err := json.Marshal(st)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+
+	if err := s.Redis.Set(ctx, "oauth_state:"+state, payload, s.Config().OAuth.StateTTLDur).Err(); err != nil {
+		slog.ErrorContext(ctx, "redis set oauth state failed", "err", err)
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+
+	opts := []oauth2.AuthCodeOption{oauth2.S256ChallengeOption(st.Verifier)}
+	if client.verifier != nil {
+		opts = append(opts, oidc.Nonce(nonce))
+	}
+
+	http.Redirect(w, r, client.oauth2.AuthCodeURL(state, opts...), http.StatusFound)
+}
+
+// AuthOAuthCallback implements [api.ServerInterface].
+func (s *Server) AuthOAuthCallback(w http.ResponseWriter, r *http.Request, provider string, params api.AuthOAuthCallbackParams) {
+	ctx := r.Context()
+
+	client, err := s.oauthClient(provider)
+	if err != nil {
+		s.oauthError(w, r, provider, err)
+		return
+	}
+
+	raw, err := s.Redis.GetDel(ctx, "oauth_state:"+params.State).Bytes()
+	if errors.Is(err, redis.Nil) {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid or expired state", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "redis get oauth state failed", "err", err)
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+
+	var st oauthState
+	if err := json.Unmarshal(raw, &st); err != nil || st.Provider != provider {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid or expired state", "error")
+		return
+	}
+
+	token, err := client.oauth2.Exchange(ctx, params.Code, oauth2.VerifierOption(st.Verifier))
+	if err != nil {
+		slog.WarnContext(ctx, "oauth code exchange failed", "provider", provider, "err", err)
+		s.JSON(w, r, http.StatusUnauthorized, "Code exchange failed", "error")
+		return
+	}
+
+	profile, err := client.profile(ctx, token, st.Nonce)
+	if err != nil {
+		slog.WarnContext(ctx, "oauth profile failed", "provider", provider, "err", err)
+		s.JSON(w, r, http.StatusUnauthorized, "Provider did not confirm identity", "error")
+		return
+	}
+
+	if st.Intent == oauthIntentReauth {
+		s.issueReauthToken(w, r, provider, profile)
+		return
+	}
+
+	user, err := s.userForIdentity(ctx, provider, profile)
+	if err != nil {
+		s.oauthError(w, r, provider, err)
+		return
+	}
+
+	// Внешний провайдер заменяет только пароль: включённая 2FA проверяется так же, как при входе по паролю
+	if user.TotpEnabled {
+		s.issueMfaChallenge(w, r, user)
+		return
+	}
+
+	metrics.Logins.WithLabelValues("oauth").Inc()
+	s.touchLastLogin(ctx, user)
+	s.issueTokens(w, r, user)
+}
+
+// issueReauthToken — одноразовый токен подтверждения для пользователя, к которому уже привязана
+// эта личность. Новые личности при повторном входе не привязываются
+func (s *Server) issueReauthToken(w http.ResponseWriter, r *http.Request, provider string, profile *externalProfile) {
+	ctx := r.Context()
+
+	identity, err := storage.GetOne[models.Identity](ctx, s.DB, "user_identities", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("provider", provider), sb.Equal("subject", profile.Subject))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.oauthError(w, r, provider, errOAuthNotLinked)
+		return
+	}
+	if err != nil {
+		s.oauthError(w, r, provider, err)
+		return
+	}
+
+	token, err := randomHex(32)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Token generation error", "error")
+		return
+	}
+
+	ttl := s.Config().Auth.MfaChallengeDur
+	if err := s.Redis.Set(ctx, "oauth_reauth:"+token, identity.UserID.String(), ttl).Err(); err != nil {
+		slog.ErrorContext(ctx, "redis set oauth reauth failed", "err", err)
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, map[string]any{
+		"reauth_token": token,
+		"expires_in":   int(ttl.Seconds()),
+	}, "auth")
+}
+
+// userForIdentity — находит пользователя по внешней личности; при первом входе
+// привязывает личность к пользователю с тем же подтверждённым email или создаёт нового
+func (s *Server) userForIdentity(ctx context.Context, provider string, profile *externalProfile) (*models.User, error) {
+	identity, err := storage.GetOne[models.Identity](ctx, s.DB, "user_identities", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("provider", provider), sb.Equal("subject", profile.Subject))
+	})
+	if err == nil {
+		return storage.GetOne[models.User](ctx, s.DB, "users", func(sb *sqlbuilder.SelectBuilder) {
+			sb.Where(sb.Equal("id", identity.UserID))
+		})
+	}
+	if !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	if profile.Email == "" {
+		return nil, errOAuthEmailRequired
+	}
+
+	user, err := storage.GetOne[models.User](ctx, s.DB, "users", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("email", profile.Email))
+	})
+	switch {
+	case err == nil && !profile.EmailVerified:
+		return nil, errOAuthEmailTaken
+	case err != nil && !errors.Is(err, storage.ErrNotFound):
+		return nil, err
+	case err != nil && !profile.EmailVerified:
+		return nil, errOAuthEmailRequired
+	}
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now()
+	if user == nil {
+		id := uuid.New()
+		user = &models.User{
+			ID:        id,
+			Slug:      GenerateUserSlug(profile.Name, id),
+			CreatedAt: now,
+			UpdatedAt: now,
+			Email:     profile.Email,
+			FullName:  profile.Name,
+			AvatarURL: profile.AvatarURL,
+			Role:      models.RoleStudent,
+		}
+		if err := storage.Create(ctx, "users", *user, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := storage.Create(ctx, "user_identities", models.Identity{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Provider:  provider,
+		Subject:   profile.Subject,
+		Email:     profile.Email,
+		CreatedAt: now,
+	}, tx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "external identity linked", "provider", provider, "user_id", user.ID)
+	return user, nil
+}
+
+// oauthClient — возвращает провайдера по имени, при первом обращении выполняет OIDC discovery
+func (s *Server) oauthClient(name string) (*oauthClient, error) {
+	s.oauthMu.Lock()
+	defer s.oauthMu.Unlock()
+
+	if client, ok := s.oauthClients[name]; ok {
+		return client, nil
+	}
+
+	p, ok := s.Config().OAuth.Providers[name]
+	if !ok || p.ClientID == "" {
+		return nil, errOAuthProviderNotFound
+	}
+
+	client := &oauthClient{
+		cfg: p,
+		oauth2: &oauth2.Config{
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+			Scopes:       p.Scopes,
+			Endpoint:     oauth2.Endpoint{AuthURL: p.AuthURL, TokenURL: p.TokenURL},
+		},
+	}
+
+	if p.Issuer != "" {
+		// Контекст сервера: провайдер использует его и для последующей загрузки ключей
+		provider, err := oidc.NewProvider(s.ctx, p.Issuer)
+		if err != nil {
+			return nil, fmt.Errorf("oidc discovery %s: %w", name, err)
+		}
+		client.oauth2.Endpoint = provider.Endpoint()
+		client.verifier = provider.Verifier(&oidc.Config{ClientID: p.ClientID})
+	}
+
+	s.oauthClients[name] = client
+	return client, nil
+}
+
+// profile — достаёт профиль пользователя: из ID-токена для OIDC или из userinfo для OAuth2
+func (c *oauthClient) profile(ctx context.Context, token *oauth2.Token, nonce string) (*externalProfile, error) {
+	if c.verifier != nil {
+		return c.oidcProfile(ctx, token, nonce)
+	}
+	return c.userInfoProfile(ctx, token)
+}
+
+func (c *oauthClient) oidcProfile(ctx context.Context, token *oauth2.Token, nonce string) (*externalProfile, error) {
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("missing id_token")
+	}
+
+	idToken, err := c.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify id_token: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, errors.New("nonce mismatch")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+		Picture       string `json:"picture"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("parse id_token claims: %w", err)
+	}
+
+	return &externalProfile{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+		AvatarURL:     claims.Picture,
+	}, nil
+}
+
+func (c *oauthClient) userInfoProfile(ctx context.Context, token *oauth2.Token) (*externalProfile, error) {
+	httpClient := c.oauth2.Client(ctx, token)
+
+	var info struct {
+		ID        json.Number `json:"id"`
+		Login     string      `json:"login"`
+		Name      string      `json:"name"`
+		AvatarURL string      `json:"avatar_url"`
+	}
+	if err := getJSON(ctx, httpClient, c.cfg.UserInfoURL, &info); err != nil {
+		return nil, err
+	}
+	if info.ID == "" {
+		return nil, errors.New("userinfo without id")
+	}
+
+	profile := &externalProfile{
+		Subject:   info.ID.String(),
+		Name:      info.Name,
+		AvatarURL: info.AvatarURL,
+	}
+	if profile.Name == "" {
+		profile.Name = info.Login
+	}
+
+	// Публичный email в профиле GitHub может быть не подтверждён, поэтому
+	// берём основной подтверждённый адрес из отдельного эндпоинта
+	if c.cfg.EmailsURL != "" {
+		var emails []struct {
+			Email    string `json:"email"`
+			Primary  bool   `json:"primary"`
+			Verified bool   `json:"verified"`
+		}
+		if err := getJSON(ctx, httpClient, c.cfg.EmailsURL, &emails); err != nil {
+			return nil, err
+		}
+		for _, e := range emails {
+			if e.Primary && e.Verified {
+				profile.Email, profile.EmailVerified = e.Email, true
+				break
+			}
+		}
+	}
+
+	return profile, nil
+}
+
+// oauthError — переводит ошибки входа через провайдера в HTTP-ответ
+func (s *Server) oauthError(w http.ResponseWriter, r *http.Request, provider string, err error) {
+	switch {
+	case errors.Is(err, errOAuthProviderNotFound):
+		s.JSON(w, r, http.StatusNotFound, "Unknown provider", "error")
+	case errors.Is(err, errOAuthEmailRequired), errors.Is(err, errOAuthNotLinked):
+		s.JSON(w, r, http.StatusUnauthorized, err.Error(), "error")
+	case errors.Is(err, errOAuthEmailTaken):
+		s.JSON(w, r, http.StatusConflict, err.Error(), "error")
+	default:
+		slog.ErrorContext(r.Context(), "oauth login failed", "provider", provider, "err", err)
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+	}
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", url, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
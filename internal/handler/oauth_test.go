@@ -0,0 +1,187 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"handbooks/internal/api"
+	"handbooks/internal/apitest"
+	"handbooks/internal/models"
+
+	"github.com/pquerna/otp/totp"
+)
+
+const mockProvider = "mock"
+
+var reauth = api.AuthOAuthStartParamsIntent("reauth")
+
+func TestOAuthLogin(t *testing.T) {
+	idp := apitest.NewMockIdP(t)
+	h := apitest.New(t, apitest.WithOIDCProvider(mockProvider, idp))
+	ctx := context.Background()
+
+	profile := apitest.IdPUser{Subject: "oauth-1", Email: "oauth-1@apitest.local", EmailVerified: true, Name: "OAuth User"}
+
+	t.Run("first login creates user", func(t *testing.T) {
+		s := h.OAuthLogin(mockProvider, idp, profile)
+
+		me, err := s.Client.GetCurrentUserWithResponse(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if me.StatusCode() != http.StatusOK {
+			t.Fatalf("GET /me: %d: %s", me.StatusCode(), me.Body)
+		}
+
+		user := s.User()
+		if user.Email != profile.Email || user.Role != models.RoleStudent || user.PasswordHash != "" {
+			t.Fatalf("unexpected user: %+v", user)
+		}
+	})
+
+	t.Run("second login reuses identity", func(t *testing.T) {
+		first := h.OAuthLogin(mockProvider, idp, profile)
+		second := h.OAuthLogin(mockProvider, idp, profile)
+		if first.UserID != second.UserID {
+			t.Fatalf("identity linked twice: %s != %s", first.UserID, second.UserID)
+		}
+	})
+
+	t.Run("unverified email of existing account", func(t *testing.T) {
+		existing := h.NewUser(models.RoleStudent)
+
+		resp := h.OAuthCallback(mockProvider, idp, apitest.IdPUser{
+			Subject: "oauth-unverified",
+			Email:   existing.Email,
+			Name:    "Intruder",
+		}, api.AuthOAuthStartParams{})
+		if resp.StatusCode() != http.StatusConflict {
+			t.Fatalf("expected 409, got %d: %s", resp.StatusCode(), resp.Body)
+		}
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		resp, err := h.Anonymous().Client.AuthOAuthCallbackWithResponse(ctx, "unknown", &api.AuthOAuthCallbackParams{Code: "x", State: "x"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusNotFound {
+			t.Fatalf("expected 404, got %d", resp.StatusCode())
+		}
+	})
+
+	t.Run("forged state", func(t *testing.T) {
+		resp, err := h.Anonymous().Client.AuthOAuthCallbackWithResponse(ctx, mockProvider, &api.AuthOAuthCallbackParams{Code: "x", State: "forged"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", resp.StatusCode())
+		}
+	})
+}
+
+func TestOAuthLoginRequiresSecondFactor(t *testing.T) {
+	idp := apitest.NewMockIdP(t)
+	h := apitest.New(t, apitest.WithOIDCProvider(mockProvider, idp))
+	ctx := context.Background()
+
+	profile := apitest.IdPUser{Subject: "oauth-mfa", Email: "oauth-mfa@apitest.local", EmailVerified: true, Name: "OAuth MFA"}
+	secret, _ := h.OAuthLogin(mockProvider, idp, profile).EnableTotp()
+
+	resp := h.OAuthCallback(mockProvider, idp, profile, api.AuthOAuthStartParams{})
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("callback: %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	challenge := apitest.Data[struct {
+		MfaRequired    bool   `json:"mfa_required"`
+		ChallengeToken string `json:"challenge_token"`
+		AccessToken    string `json:"access_token"`
+	}](t, resp.Body, "auth")
+	if !challenge.MfaRequired || challenge.ChallengeToken == "" || challenge.AccessToken != "" {
+		t.Fatalf("expected 2FA challenge instead of tokens: %s", resp.Body)
+	}
+
+	code, err := totp.GenerateCode(secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verify, err := h.Anonymous().Client.AuthVerifyMfaWithResponse(ctx, api.AuthVerifyMfaJSONRequestBody{
+		ChallengeToken: challenge.ChallengeToken,
+		Code:           code,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := h.SessionFromAuth(verify.StatusCode(), verify.Body)
+	if s.Email != profile.Email {
+		t.Fatalf("logged in as %s, want %s", s.Email, profile.Email)
+	}
+}
+
+func TestOAuthReauthDeletesPasswordlessAccount(t *testing.T) {
+	idp := apitest.NewMockIdP(t)
+	h := apitest.New(t, apitest.WithOIDCProvider(mockProvider, idp))
+	ctx := context.Background()
+
+	profile := apitest.IdPUser{Subject: "oauth-delete", Email: "oauth-delete@apitest.local", EmailVerified: true, Name: "OAuth Delete"}
+	s := h.OAuthLogin(mockProvider, idp, profile)
+
+	empty, err := s.Client.DeleteCurrentUserWithResponse(ctx, api.DeleteCurrentUserJSONRequestBody{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty.StatusCode() != http.StatusBadRequest {
+		t.Fatalf("delete without confirmation: expected 400, got %d", empty.StatusCode())
+	}
+
+	stranger := h.OAuthCallback(mockProvider, idp, apitest.IdPUser{Subject: "not-linked"}, api.AuthOAuthStartParams{Intent: &reauth})
+	if stranger.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("reauth of unlinked identity: expected 401, got %d: %s", stranger.StatusCode(), stranger.Body)
+	}
+
+	resp := h.OAuthCallback(mockProvider, idp, profile, api.AuthOAuthStartParams{Intent: &reauth})
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("reauth: %d: %s", resp.StatusCode(), resp.Body)
+	}
+	token := apitest.Data[struct {
+		ReauthToken string `json:"reauth_token"`
+	}](t, resp.Body, "auth").ReauthToken
+
+	// Reauth-токен чужого аккаунта не подходит
+	other := h.NewUser(models.RoleStudent)
+	foreign, err := other.Client.DeleteCurrentUserWithResponse(ctx, api.DeleteCurrentUserJSONRequestBody{ReauthToken: &token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if foreign.StatusCode() != http.StatusBadRequest {
+		t.Fatalf("foreign reauth token: expected 400, got %d", foreign.StatusCode())
+	}
+
+	// Токен одноразовый и сгорел на чужой попытке
+	burned, err := s.Client.DeleteCurrentUserWithResponse(ctx, api.DeleteCurrentUserJSONRequestBody{ReauthToken: &token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if burned.StatusCode() != http.StatusBadRequest {
+		t.Fatalf("reused reauth token: expected 400, got %d", burned.StatusCode())
+	}
+
+	resp = h.OAuthCallback(mockProvider, idp, profile, api.AuthOAuthStartParams{Intent: &reauth})
+	token = apitest.Data[struct {
+		ReauthToken string `json:"reauth_token"`
+	}](t, resp.Body, "auth").ReauthToken
+
+	deleted, err := s.Client.DeleteCurrentUserWithResponse(ctx, api.DeleteCurrentUserJSONRequestBody{ReauthToken: &token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.StatusCode() != http.StatusOK {
+		t.Fatalf("delete with reauth token: expected 200, got %d: %s", deleted.StatusCode(), deleted.Body)
+	}
+}
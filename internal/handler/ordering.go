@@ -0,0 +1,324 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"handbooks/internal/api"
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+)
+
+// Структура курса (состав и порядок разделов и уроков) меняется только под блокировкой строки курса,
+// поэтому параллельные перестановки и создание элементов одного курса выполняются по очереди.
+// Уникальность (родитель, order) проверяется отложенно, на COMMIT.
+
+// ReorderSections implements [api.ServerInterface].
+func (s *Server) ReorderSections(w http.ResponseWriter, r *http.Request, courseID string) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	var req api.ReorderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	course, ok := s.lockCourseForEdit(w, r, tx, courseID, claims)
+	if !ok {
+		return
+	}
+
+	sections, err := storage.GetAll[models.Section](ctx, "sections", tx, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("course_id", course.ID))
+	})
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	current := make([]uuid.UUID, 0, len(sections))
+	for _, section := range sections {
+		current = append(current, section.ID)
+	}
+	if !samePermutation(req.Ids, current) {
+		s.JSON(w, r, http.StatusBadRequest, "ids must list every section of the course exactly once", "error")
+		return
+	}
+
+	if err := applyOrder(ctx, tx, "sections", req.Ids); err != nil {
+		slog.ErrorContext(ctx, "Error reordering sections", slog.String("course_id", course.ID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	byID := make(map[uuid.UUID]models.Section, len(sections))
+	for _, section := range sections {
+		byID[section.ID] = section
+	}
+	ordered := make([]models.Section, 0, len(req.Ids))
+	for i, id := range req.Ids {
+		section := byID[id]
+		section.Order = i + 1
+		ordered = append(ordered, section)
+	}
+
+	s.JSON(w, r, http.StatusOK, ordered, "sections")
+}
+
+// ReorderLessons implements [api.ServerInterface].
+// Уроки из других разделов того же курса, перечисленные в ids, переносятся в этот раздел.
+func (s *Server) ReorderLessons(w http.ResponseWriter, r *http.Request, sectionID string) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	var req api.ReorderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	section, err := storage.GetOne[models.Section](ctx, s.DB, "sections", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", sectionID))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Section not found", "error")
+		return
+	}
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	course, ok := s.lockCourseForEdit(w, r, tx, section.CourseID.String(), claims)
+	if !ok {
+		return
+	}
+
+	lessons, err := storage.GetAll[models.Lesson](ctx, "lessons", tx, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("course_id", course.ID))
+	})
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	// Раздел мог переехать или удалиться, пока ждали блокировку курса
+	if !slices.ContainsFunc(lessons, func(l models.Lesson) bool { return l.SectionID == section.ID }) {
+		if _, err := storage.GetOne[models.Section](ctx, tx, "sections", func(sb *sqlbuilder.SelectBuilder) {
+			sb.Where(sb.Equal("id", section.ID), sb.Equal("course_id", course.ID))
+		}); errors.Is(err, storage.ErrNotFound) {
+			s.JSON(w, r, http.StatusNotFound, "Section not found", "error")
+			return
+		} else if err != nil {
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+	}
+
+	byID := make(map[uuid.UUID]models.Lesson, len(lessons))
+	var current []uuid.UUID
+	for _, lesson := range lessons {
+		byID[lesson.ID] = lesson
+		if lesson.SectionID == section.ID {
+			current = append(current, lesson.ID)
+		}
+	}
+
+	// Каждый урок раздела ровно один раз, плюс уроки этого же курса, которые переносятся сюда
+	seen := make(map[uuid.UUID]bool, len(req.Ids))
+	for _, id := range req.Ids {
+		if _, ok := byID[id]; !ok || seen[id] {
+			s.JSON(w, r, http.StatusBadRequest, "ids must contain lessons of this course without duplicates", "error")
+			return
+		}
+		seen[id] = true
+	}
+	for _, id := range current {
+		if !seen[id] {
+			s.JSON(w, r, http.StatusBadRequest, "ids must list every lesson of the section", "error")
+			return
+		}
+	}
+
+	// Переносимые уроки получают свободный slug в новом разделе, а по прежнему адресу отдаётся редирект.
+	// Переносим по одному, чтобы следующий урок видел slug уже перенесённых
+	slugs := make(map[uuid.UUID]string)
+	for _, id := range req.Ids {
+		lesson := byID[id]
+		if lesson.SectionID == section.ID {
+			continue
+		}
+		newSlug, err := lessonSlugs.generate(ctx, tx, section.ID, lesson.Slug, lesson.ID)
+		if err == nil {
+			err = lessonSlugs.rename(ctx, tx, lesson.SectionID, lesson.ID, lesson.Slug, newSlug)
+		}
+		if err == nil {
+			err = storage.UpdateFields(ctx, "lessons", map[string]any{"section_id": section.ID, "slug": newSlug}, tx, func(ub *sqlbuilder.UpdateBuilder) {
+				ub.Where(ub.Equal("id", lesson.ID))
+			})
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Error moving lesson", slog.String("lesson_id", id.String()), slog.String("error", err.Error()))
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+		slugs[id] = newSlug
+	}
+
+	if err := applyOrder(ctx, tx, "lessons", req.Ids); err != nil {
+		slog.ErrorContext(ctx, "Error reordering lessons", slog.String("section_id", sectionID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	ordered := make([]models.Lesson, 0, len(req.Ids))
+	for i, id := range req.Ids {
+		lesson := byID[id]
+		if lesson.SectionID != section.ID {
+			slog.InfoContext(ctx, "lesson moved",
+				slog.String("lesson_id", id.String()),
+				slog.String("from_section_id", lesson.SectionID.String()),
+				slog.String("to_section_id", section.ID.String()),
+			)
+		}
+		if slug, ok := slugs[id]; ok {
+			lesson.Slug = slug
+		}
+		lesson.SectionID = section.ID
+		lesson.Order = i + 1
+		ordered = append(ordered, lesson)
+	}
+
+	s.JSON(w, r, http.StatusOK, ordered, "lessons")
+}
+
+// lockCourseForEdit — блокирует строку курса до конца транзакции и проверяет, что пользователь
+// может менять его структуру. При ошибке сам пишет ответ
+func (s *Server) lockCourseForEdit(w http.ResponseWriter, r *http.Request, tx pgx.Tx, courseID string, claims *Claims) (*models.Course, bool) {
+	ctx := r.Context()
+
+	course, err := storage.GetOne[models.Course](ctx, tx, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID)).ForUpdate()
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Course not found", "error")
+		return nil, false
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error locking course", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return nil, false
+	}
+
+	allowed, err := canManageCourse(ctx, tx, course, claims)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return nil, false
+	}
+	if !allowed {
+		s.JSON(w, r, http.StatusForbidden, "Forbidden", "error")
+		return nil, false
+	}
+
+	return course, true
+}
+
+// applyOrder — проставляет order = 1..n в порядке ids
+func applyOrder(ctx context.Context, tx pgx.Tx, table string, ids []uuid.UUID) error {
+	now := time.Now()
+	for i, id := range ids {
+		fields := map[string]any{
+			`"order"`:    i + 1,
+			"updated_at": now,
+		}
+		if err := storage.UpdateFields(ctx, table, fields, tx, func(ub *sqlbuilder.UpdateBuilder) {
+			ub.Where(ub.Equal("id", id))
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reserveOrder — позиция для нового элемента родителя. requested <= 0 или за концом списка — в конец,
+// иначе элементы начиная с requested сдвигаются на одну позицию. Вызывать под lockCourseForEdit
+func reserveOrder(ctx context.Context, tx pgx.Tx, table, parentColumn string, parentID uuid.UUID, requested int) (int, error) {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select(`COALESCE(MAX("order"), 0)`).From(table).Where(sb.Equal(parentColumn, parentID))
+	query, args := sb.Build()
+
+	var last int
+	if err := tx.QueryRow(ctx, query, args...).Scan(&last); err != nil {
+		return 0, err
+	}
+	if requested <= 0 || requested > last {
+		return last + 1, nil
+	}
+
+	ub := sqlbuilder.PostgreSQL.NewUpdateBuilder()
+	ub.Update(table).
+		Set(`"order" = "order" + 1`).
+		Where(ub.Equal(parentColumn, parentID), ub.GreaterEqualThan(`"order"`, requested))
+	query, args = ub.Build()
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return 0, err
+	}
+	return requested, nil
+}
+
+// samePermutation — содержит ли ids каждый элемент current ровно один раз и ничего лишнего
+func samePermutation(ids, current []uuid.UUID) bool {
+	if len(ids) != len(current) {
+		return false
+	}
+	want := make(map[uuid.UUID]bool, len(current))
+	for _, id := range current {
+		want[id] = true
+	}
+	for _, id := range ids {
+		if !want[id] {
+			return false
+		}
+		delete(want, id)
+	}
+	return true
+}
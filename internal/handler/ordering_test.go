@@ -0,0 +1,201 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"handbooks/internal/api"
+	"handbooks/internal/apitest"
+	"handbooks/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// sectionOrder — id разделов курса в порядке GET /sections
+func sectionOrder(t *testing.T, s *apitest.Session, courseID string) []uuid.UUID {
+	t.Helper()
+
+	resp, err := s.Client.GetSectionsWithResponse(context.Background(), courseID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "sections", resp.StatusCode(), http.StatusOK)
+
+	var ids []uuid.UUID
+	for _, section := range apitest.Data[[]models.Section](t, resp.Body, "sections") {
+		ids = append(ids, section.ID)
+	}
+	return ids
+}
+
+// lessonOrder — id уроков раздела в порядке GET /lessons, включая черновики
+func lessonOrder(t *testing.T, s *apitest.Session, courseID, sectionID string) []uuid.UUID {
+	t.Helper()
+
+	resp, err := s.Client.GetLessonsWithResponse(context.Background(), courseID, sectionID, &api.GetLessonsParams{PublishedOnly: ptr(false)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "lessons", resp.StatusCode(), http.StatusOK)
+
+	var ids []uuid.UUID
+	for _, lesson := range apitest.Data[[]models.Lesson](t, resp.Body, "lessons") {
+		ids = append(ids, lesson.ID)
+	}
+	return ids
+}
+
+func TestReorderSections(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	student := h.NewUser(models.RoleStudent)
+
+	course := createCourse(t, owner, true)
+	first := uuid.MustParse(course.SectionID)
+	second := newSection(t, owner, course.ID, "Второй").ID
+	third := newSection(t, owner, course.ID, "Третий").ID
+
+	t.Run("new order is applied", func(t *testing.T) {
+		want := []uuid.UUID{third, first, second}
+		resp, err := owner.Client.ReorderSectionsWithResponse(ctx, course.ID, api.ReorderSectionsJSONRequestBody{Ids: want})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "reorder", resp.StatusCode(), http.StatusOK)
+
+		for i, section := range apitest.Data[[]models.Section](t, resp.Body, "sections") {
+			if section.ID != want[i] || section.Order != i+1 {
+				t.Fatalf("position %d: %+v", i, section)
+			}
+		}
+		if got := sectionOrder(t, owner, course.ID); !slices.Equal(got, want) {
+			t.Fatalf("sections %v, want %v", got, want)
+		}
+	})
+
+	t.Run("ids must be a permutation", func(t *testing.T) {
+		for name, ids := range map[string][]uuid.UUID{
+			"missing":   {first, second},
+			"duplicate": {first, second, second},
+			"foreign":   {first, second, uuid.New()},
+		} {
+			resp, err := owner.Client.ReorderSectionsWithResponse(ctx, course.ID, api.ReorderSectionsJSONRequestBody{Ids: ids})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectStatus(t, name, resp.StatusCode(), http.StatusBadRequest)
+		}
+	})
+
+	t.Run("only managers reorder", func(t *testing.T) {
+		resp, err := student.Client.ReorderSectionsWithResponse(ctx, course.ID, api.ReorderSectionsJSONRequestBody{Ids: []uuid.UUID{first, second, third}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "student reorder", resp.StatusCode(), http.StatusForbidden)
+
+		missing, err := owner.Client.ReorderSectionsWithResponse(ctx, uuid.NewString(), api.ReorderSectionsJSONRequestBody{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "unknown course", missing.StatusCode(), http.StatusNotFound)
+	})
+}
+
+func TestReorderLessons(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	other := h.NewUser(models.RoleInstructor)
+
+	course := createCourse(t, owner, true)
+	source := course.SectionID
+	target := newSection(t, owner, course.ID, "Второй раздел").ID.String()
+
+	moved := newLesson(t, owner, course.ID, source, "Урок")
+	stays := newLesson(t, owner, course.ID, source, "Остаётся")
+	local := newLesson(t, owner, course.ID, target, "Урок")
+	foreign := createCourse(t, other, true)
+
+	t.Run("lessons are reordered within a section", func(t *testing.T) {
+		want := []uuid.UUID{stays.ID, uuid.MustParse(course.LessonID), moved.ID}
+		resp, err := owner.Client.ReorderLessonsWithResponse(ctx, source, api.ReorderLessonsJSONRequestBody{Ids: want})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "reorder", resp.StatusCode(), http.StatusOK)
+
+		if got := lessonOrder(t, owner, course.ID, source); !slices.Equal(got, want) {
+			t.Fatalf("lessons %v, want %v", got, want)
+		}
+	})
+
+	t.Run("lesson moves between sections", func(t *testing.T) {
+		want := []uuid.UUID{moved.ID, local.ID}
+		resp, err := owner.Client.ReorderLessonsWithResponse(ctx, target, api.ReorderLessonsJSONRequestBody{Ids: want})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "move", resp.StatusCode(), http.StatusOK)
+
+		lessons := apitest.Data[[]models.Lesson](t, resp.Body, "lessons")
+		if lessons[0].SectionID.String() != target || lessons[0].Slug != local.Slug+"-2" {
+			t.Fatalf("moved lesson: %+v", lessons[0])
+		}
+		if got := lessonOrder(t, owner, course.ID, target); !slices.Equal(got, want) {
+			t.Fatalf("target lessons %v, want %v", got, want)
+		}
+		if got := lessonOrder(t, owner, course.ID, source); slices.Contains(got, moved.ID) {
+			t.Fatalf("moved lesson is still in the source section: %v", got)
+		}
+
+		// Прежний адрес урока ведёт в новый раздел
+		sections, err := owner.Client.GetSectionsWithResponse(ctx, course.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		slugs := make(map[string]string)
+		for _, section := range apitest.Data[[]models.Section](t, sections.Body, "sections") {
+			slugs[section.ID.String()] = section.Slug
+		}
+		courseResp, err := owner.Client.GetCourseByIDWithResponse(ctx, course.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		courseSlug := apitest.Data[models.Course](t, courseResp.Body, "course").Slug
+
+		req, err := api.NewGetLessonBySlugRequest(h.HTTP.URL, courseSlug, slugs[source], moved.Slug)
+		if err != nil {
+			t.Fatal(err)
+		}
+		location := "/courses/by-slug/" + courseSlug + "/sections/" + slugs[target] + "/lessons/" + lessons[0].Slug
+		if got := slugRedirect(t, h, owner, req); got != location {
+			t.Fatalf("Location = %q, want %q", got, location)
+		}
+	})
+
+	t.Run("ids are validated", func(t *testing.T) {
+		for name, ids := range map[string][]uuid.UUID{
+			"missing":      {moved.ID},
+			"duplicate":    {moved.ID, local.ID, local.ID},
+			"other course": {moved.ID, local.ID, uuid.MustParse(foreign.LessonID)},
+		} {
+			resp, err := owner.Client.ReorderLessonsWithResponse(ctx, target, api.ReorderLessonsJSONRequestBody{Ids: ids})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectStatus(t, name, resp.StatusCode(), http.StatusBadRequest)
+		}
+
+		denied, err := other.Client.ReorderLessonsWithResponse(ctx, target, api.ReorderLessonsJSONRequestBody{Ids: []uuid.UUID{moved.ID, local.ID}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "foreign instructor", denied.StatusCode(), http.StatusForbidden)
+	})
+}
@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"handbooks/internal/api"
+	"handbooks/internal/metrics"
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+)
+
+// courseProgress — прогресс пользователя по одному курсу
+type courseProgress struct {
+	CourseID string  `json:"courseID"`
+	Progress float64 `json:"progress"`
+	Status   string  `json:"status"`
+}
+
+// GetUserProgress implements [api.ServerInterface].
+func (s *Server) GetUserProgress(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	// Прогресс — доля пройденных опубликованных уроков текущей версии курса, в процентах.
+	// Прогресс по урокам, которых в новой версии нет, не учитывается
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select(
+		"e.course_id::text",
+		"COALESCE(ROUND(100.0 * COUNT(p.id) / NULLIF(COUNT(l.id), 0), 1), 0)::float8",
+		"e.status",
+	).
+		From("enrollments e").
+		Join("courses c", "c.id = e.course_id").
+		JoinWithOption(sqlbuilder.LeftJoin, "course_version_lessons l", "l.version_id = c.published_version_id", visibleLessonsOf("l")).
+		JoinWithOption(sqlbuilder.LeftJoin, "lesson_progress p", "p.lesson_id = l.id", "p.user_id = e.user_id", "p.completed_at IS NOT NULL").
+		Where(sb.Equal("e.user_id", claims.ID)).
+		GroupBy("e.course_id", "e.status", "e.enrolled_at").
+		OrderByDesc("e.enrolled_at")
+	query, args := sb.Build()
+
+	rows, err := s.DB.Query(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting user progress", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	progress, err := pgx.CollectRows(rows, pgx.RowToStructByPos[courseProgress])
+	if err != nil {
+		slog.ErrorContext(ctx, "Error scanning user progress", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, progress, "progress")
+}
+
+// UpdateLessonProgress implements [api.ServerInterface].
+func (s *Server) UpdateLessonProgress(w http.ResponseWriter, r *http.Request, courseID string, lessonID string) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	var req api.UpdateLessonProgressJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	enrollment, err := storage.GetOne[models.Enrollment](ctx, tx, "enrollments", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("course_id", courseID), sb.Equal("user_id", claims.ID))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusForbidden, "Not enrolled", "error")
+		return
+	}
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	course, err := storage.GetOne[models.Course](ctx, tx, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID))
+	})
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	access, err := loadCourseAccess(ctx, tx, course, claims)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error loading course access", slog.String("course_id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	// Урок ищется в той версии курса, которую видит пользователь; прогресс привязан к id урока
+	lesson, err := storage.GetOne[models.Lesson](ctx, tx, access.content.lessons(), func(sb *sqlbuilder.SelectBuilder) {
+		access.content.scope(sb)
+		sb.Where(sb.Equal("id", lessonID), sb.Equal("course_id", courseID), lessonVisibleCond)
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Lesson not found", "error")
+		return
+	}
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	if lock := access.lessonLock(*lesson); lock.Locked {
+		s.JSON(w, r, http.StatusForbidden, lock, "lock")
+		return
+	}
+
+	progress, err := lockLessonProgress(ctx, tx, enrollment, lesson.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error loading lesson progress", slog.String("lesson_id", lessonID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	now := time.Now()
+	if req.Percent != nil {
+		progress.Percent = min(max(float64(*req.Percent), 0), 100)
+	}
+	if req.LastWatchedSec != nil {
+		progress.LastWatchedSec = max(*req.LastWatchedSec, 0)
+	}
+	// Пройденный урок остаётся пройденным, даже если прогресс потом уменьшился
+	newlyCompleted := progress.CompletedAt == nil && ((req.Completed != nil && *req.Completed) || progress.Percent >= 100)
+	if newlyCompleted {
+		progress.CompletedAt = &now
+		progress.Percent = 100
+	}
+	progress.UpdatedAt = now
+
+	if err := storage.Update(ctx, "lesson_progress", *progress, tx, func(ub *sqlbuilder.UpdateBuilder) {
+		ub.Where(ub.Equal("id", progress.ID))
+	}); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	courseCompleted := false
+	if newlyCompleted && enrollment.Status == models.EnrollmentStatusActive {
+		courseCompleted, err = completeEnrollmentIfDone(ctx, tx, enrollment, now)
+		if err != nil {
+			slog.ErrorContext(ctx, "Error completing enrollment", slog.String("course_id", courseID), slog.String("error", err.Error()))
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if newlyCompleted {
+		metrics.LessonCompletions.Inc()
+	}
+	if courseCompleted {
+		slog.InfoContext(ctx, "course completed", slog.String("course_id", courseID))
+	}
+
+	s.JSON(w, r, http.StatusOK, progress, "progress")
+}
+
+// lockLessonProgress — создаёт запись прогресса, если её ещё нет, и блокирует её до конца транзакции.
+// ON CONFLICT избавляет от гонки двух первых запросов прогресса по одному уроку
+func lockLessonProgress(ctx context.Context, tx pgx.Tx, enrollment *models.Enrollment, lessonID uuid.UUID) (*models.LessonProgress, error) {
+	ib := sqlbuilder.NewStruct(new(models.LessonProgress)).For(sqlbuilder.PostgreSQL).InsertInto("lesson_progress", models.LessonProgress{
+		ID:        uuid.New(),
+		UserID:    enrollment.UserID,
+		CourseID:  enrollment.CourseID,
+		LessonID:  lessonID,
+		UpdatedAt: time.Now(),
+	})
+	ib.SQL("ON CONFLICT (user_id, lesson_id) DO NOTHING")
+	query, args := ib.Build()
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return nil, err
+	}
+
+	return storage.GetOne[models.LessonProgress](ctx, tx, "lesson_progress", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("user_id", enrollment.UserID), sb.Equal("lesson_id", lessonID)).ForUpdate()
+	})
+}
+
+// completeEnrollmentIfDone — помечает запись на курс пройденной, если пройдены все опубликованные уроки
+// текущей версии курса
+func completeEnrollmentIfDone(ctx context.Context, tx pgx.Tx, enrollment *models.Enrollment, now time.Time) (bool, error) {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("COUNT(*)").From("course_version_lessons l").
+		Join("courses c", "c.published_version_id = l.version_id").
+		Where(
+			sb.Equal("c.id", enrollment.CourseID),
+			visibleLessonsOf("l"),
+			"NOT EXISTS (SELECT 1 FROM lesson_progress p WHERE p.lesson_id = l.id AND p.completed_at IS NOT NULL AND p.user_id = "+sb.Var(enrollment.UserID)+")",
+		)
+	query, args := sb.Build()
+
+	var remaining int
+	if err := tx.QueryRow(ctx, query, args...).Scan(&remaining); err != nil {
+		return false, err
+	}
+	if remaining > 0 {
+		return false, nil
+	}
+
+	if err := storage.UpdateFields(ctx, "enrollments", map[string]any{
+		"status":       models.EnrollmentStatusCompleted,
+		"completed_at": now,
+	}, tx, func(ub *sqlbuilder.UpdateBuilder) {
+		ub.Where(ub.Equal("id", enrollment.ID))
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
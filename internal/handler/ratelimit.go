@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/xid"
+)
+
+// slidingWindowScript — скользящее окно на sorted set: удаляет устаревшие отметки,
+// и если лимит не исчерпан, добавляет текущий запрос.
+// Возвращает {разрешено (0/1), осталось запросов, мс до освобождения слота}.
+var slidingWindowScript = redis.NewScript(`
+local key    = KEYS[1]
+local now    = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit  = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, 0, now - window)
+local count = redis.call('ZCARD', key)
+
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	redis.call('PEXPIRE', key, window)
+	count = count + 1
+	allowed = 1
+end
+
+local reset = window
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+
+return {allowed, limit - count, reset}
+`)
+
+// rateLimitResult — результат проверки лимита
+type rateLimitResult struct {
+	allowed   bool
+	remaining int64
+	reset     time.Duration
+}
+
+// RateLimitMiddleware — ограничение частоты запросов по пользователю (из токена) или по IP.
+// Лимиты берутся из группы маршрутов в конфиге, иначе применяется общий лимит.
+func (s *Server) RateLimitMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cfg := s.Config().RateLimit
+		if !cfg.Enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		group, limit, window := "global", cfg.Limit, cfg.WindowDur
+		for i := range cfg.Groups {
+			if g := &cfg.Groups[i]; g.Matches(r.Method, r.URL.Path) {
+				group, limit, window = g.Name, g.Limit, g.WindowDur
+				break
+			}
+		}
+
+		// Лимитер стоит до AuthMiddleware, чтобы запросы без токена или с неверным токеном
+		// тоже учитывались: они считаются по IP, запросы с действующим токеном — по пользователю
+		subject := "ip:" + clientIP(r)
+		if token := r.Header.Get("Authorization"); token != "" && !isPublicPath(r.URL.Path) {
+			if claims, err := s.validateAccessToken(r.Context(), token); err == nil {
+				subject = "user:" + claims.ID.String()
+			}
+		}
+
+		res, err := s.checkRateLimit(r.Context(), "ratelimit:"+group+":"+subject, limit, window)
+		if err != nil {
+			// Недоступность Redis не должна класть API — пропускаем запрос
+			slog.ErrorContext(r.Context(), "rate limit check failed", "err", err)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		resetSec := int((res.reset + time.Second - 1) / time.Second)
+
+		w.Header().Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", limit, int(window.Seconds())))
+		w.Header().Set("RateLimit-Limit", strconv.Itoa(limit))
+		w.Header().Set("RateLimit-Remaining", strconv.FormatInt(res.remaining, 10))
+		w.Header().Set("RateLimit-Reset", strconv.Itoa(resetSec))
+
+		if !res.allowed {
+			w.Header().Set("Retry-After", strconv.Itoa(resetSec))
+			s.JSON(w, r, http.StatusTooManyRequests, "rate limit exceeded", "error")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// checkRateLimit — атомарная проверка скользящего окна в Redis
+func (s *Server) checkRateLimit(ctx context.Context, key string, limit int, window time.Duration) (*rateLimitResult, error) {
+	now := time.Now().UnixMilli()
+
+	vals, err := slidingWindowScript.Run(ctx, s.Redis, []string{key},
+		now, window.Milliseconds(), limit, strconv.FormatInt(now, 10)+"-"+xid.New().String(),
+	).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	return &rateLimitResult{
+		allowed:   vals[0] == 1,
+		remaining: max(vals[1], 0),
+		reset:     time.Duration(vals[2]) * time.Millisecond,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"handbooks/internal/config"
+	"handbooks/internal/logger"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// reloadablePrefixes — пути конфига, изменения которых применяются без перезапуска.
+// Всё остальное (адреса БД и Redis, ключ JWT, таймауты сервера, OAuth) требует рестарта.
+var reloadablePrefixes = []string{
+	"handbooks.logLevel",
+	"rateLimit.",
+	"server.corsOrigins",
+	"redis.accessTokenTTL",
+	"redis.refreshTokenTTL",
+}
+
+// Reload — применяет безопасные изменения из нового конфига и возвращает пути
+// небезопасных изменений, которые были проигнорированы до перезапуска
+func (s *Server) Reload(next *config.Config) (applied, skipped []string) {
+	current := s.Config()
+	merged := *current
+
+	for _, path := range config.Diff(current, next) {
+		if !isReloadable(path) {
+			skipped = append(skipped, path)
+			continue
+		}
+		applied = append(applied, path)
+	}
+
+	merged.Handbooks.LogLevel = next.Handbooks.LogLevel
+	merged.RateLimit = next.RateLimit
+	merged.Server.CorsOrigins = next.Server.CorsOrigins
+	merged.Redis.AccessTokenTTL = next.Redis.AccessTokenTTL
+	merged.Redis.AccessTokenDur = next.Redis.AccessTokenDur
+	merged.Redis.RefreshTokenTTL = next.Redis.RefreshTokenTTL
+	merged.Redis.RefreshTokenDur = next.Redis.RefreshTokenDur
+
+	logger.SetLevel(merged.Handbooks.LogLevel)
+	s.cfg.Store(&merged)
+
+	return applied, skipped
+}
+
+func isReloadable(path string) bool {
+	for _, prefix := range reloadablePrefixes {
+		if path == prefix || strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// originAllowed — проверка Origin по server.corsOrigins из текущего конфига.
+// Поддерживается одна "*" в шаблоне, как в AllowedOrigins у go-chi/cors.
+func (s *Server) originAllowed(_ *http.Request, origin string) bool {
+	origin = strings.ToLower(origin)
+
+	for _, pattern := range s.Config().Server.CorsOrigins {
+		pattern = strings.ToLower(pattern)
+		if pattern == "*" || pattern == origin {
+			return true
+		}
+
+		prefix, suffix, ok := strings.Cut(pattern, "*")
+		if ok && len(origin) >= len(prefix)+len(suffix) &&
+			strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+			return true
+		}
+	}
+
+	slog.Debug("CORS: origin не разрешён", slog.String("origin", origin))
+	return false
+}
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+)
+
+// scheduledPublishBatch — сколько курсов планировщик публикует за один проход
+const scheduledPublishBatch = 100
+
+// runPublishScheduler — публикует курсы и уроки, у которых наступил publish_at.
+// Запускается через goBackground и работает до остановки сервера.
+func (s *Server) runPublishScheduler(ctx context.Context) {
+	ticker := time.NewTicker(s.Config().Scheduler.IntervalDur)
+	defer ticker.Stop()
+
+	for {
+		s.publishDueLessons(ctx)
+		s.publishDueCourses(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publishDueLessons — публикует уроки одним запросом. UPDATE блокирует строки,
+// поэтому параллельные планировщики на других экземплярах не опубликуют урок дважды
+func (s *Server) publishDueLessons(ctx context.Context) {
+	ub := sqlbuilder.PostgreSQL.NewUpdateBuilder()
+	ub.Update("lessons").
+		Set(
+			ub.Assign("is_published", true),
+			ub.Assign("publish_at", nil),
+			ub.Assign("updated_at", time.Now()),
+		).
+		Where("publish_at IS NOT NULL", "publish_at <= NOW()")
+	query, args := ub.Build()
+
+	rows, err := s.DB.Query(ctx, query+" RETURNING id, course_id, section_id", args...)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.ErrorContext(ctx, "cannot publish scheduled lessons", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	type published struct {
+		ID        uuid.UUID
+		CourseID  uuid.UUID
+		SectionID uuid.UUID
+	}
+	lessons, err := pgx.CollectRows(rows, pgx.RowToStructByPos[published])
+	if err != nil {
+		slog.ErrorContext(ctx, "cannot publish scheduled lessons", slog.String("error", err.Error()))
+		return
+	}
+
+	for _, lesson := range lessons {
+		slog.InfoContext(ctx, "scheduled lesson published", slog.String("lesson_id", lesson.ID.String()))
+		s.emitEvent(ctx, EventLessonPublished, map[string]any{
+			"lessonId":  lesson.ID,
+			"courseId":  lesson.CourseID,
+			"sectionId": lesson.SectionID,
+			"scheduled": true,
+		})
+	}
+}
+
+// publishDueCourses — публикует одобренные курсы, у которых наступил publish_at.
+// Каждый курс публикуется отдельной транзакцией через тот же переход, что и вручную
+func (s *Server) publishDueCourses(ctx context.Context) {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("id").From("courses").
+		Where(sb.Equal("status", models.CourseStatusApproved), "publish_at IS NOT NULL", "publish_at <= NOW()").
+		OrderBy("publish_at").
+		Limit(scheduledPublishBatch)
+	query, args := sb.Build()
+
+	rows, err := s.DB.Query(ctx, query, args...)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.ErrorContext(ctx, "cannot select scheduled courses", slog.String("error", err.Error()))
+		}
+		return
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		slog.ErrorContext(ctx, "cannot select scheduled courses", slog.String("error", err.Error()))
+		return
+	}
+
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := s.publishScheduledCourse(ctx, id); err != nil {
+			slog.ErrorContext(ctx, "cannot publish scheduled course", slog.String("course_id", id), slog.String("error", err.Error()))
+		}
+	}
+}
+
+// publishScheduledCourse — публикует курс, если он всё ещё ждёт публикации и готов к ней.
+// Неготовый курс снимается с расписания, чтобы не проверять его на каждом проходе
+func (s *Server) publishScheduledCourse(ctx context.Context, courseID string) error {
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// SKIP LOCKED: курс, который сейчас переводит другой экземпляр или пользователь, пропускаем до следующего прохода
+	course, err := storage.GetOne[models.Course](ctx, tx, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID)).ForUpdate().SQL("SKIP LOCKED")
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if course.Status != models.CourseStatusApproved || course.PublishAt == nil || course.PublishAt.After(time.Now()) {
+		return nil
+	}
+
+	problems, err := courseReadinessProblems(ctx, tx, course)
+	if err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		slog.WarnContext(ctx, "scheduled course is not ready, schedule cleared",
+			slog.String("course_id", course.ID),
+			slog.Any("problems", problems),
+		)
+		if err := storage.UpdateFields(ctx, "courses", map[string]any{"publish_at": nil}, tx, func(ub *sqlbuilder.UpdateBuilder) {
+			ub.Where(ub.Equal("id", course.ID))
+		}); err != nil {
+			return err
+		}
+		return tx.Commit(ctx)
+	}
+
+	if _, err := applyCourseTransition(ctx, tx, course, "publish", nil, "scheduled"); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "scheduled course published", slog.String("course_id", course.ID))
+	s.emitEvent(ctx, EventCoursePublished, map[string]any{"courseId": course.ID, "scheduled": true})
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"handbooks/internal/api"
+	"handbooks/internal/apitest"
+	"handbooks/internal/config"
+	"handbooks/internal/models"
+)
+
+// listedCourses — id курсов из GET /courses от имени s
+func listedCourses(t *testing.T, s *apitest.Session) []string {
+	t.Helper()
+
+	resp, err := s.Client.GetCoursesWithResponse(context.Background(), &api.GetCoursesParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "courses", resp.StatusCode(), http.StatusOK)
+
+	var ids []string
+	for _, course := range apitest.Data[[]models.Course](t, resp.Body, "courses") {
+		ids = append(ids, course.ID)
+	}
+	return ids
+}
+
+func TestCourseListingVisibility(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	admin := h.NewUser(models.RoleAdmin)
+	student := h.NewUser(models.RoleStudent)
+
+	draft := createCourse(t, owner, true)
+	published := createCourse(t, owner, true)
+	publishCourse(t, owner, admin, published.ID)
+
+	scheduled := createCourse(t, owner, true)
+	submit, err := owner.Client.SubmitCourseWithResponse(ctx, scheduled.ID, api.CourseTransitionRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, submit.StatusCode(), submit.Body, http.StatusOK)
+	approve, err := admin.Client.ApproveCourseWithResponse(ctx, scheduled.ID, api.CourseTransitionRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, approve.StatusCode(), approve.Body, http.StatusOK)
+
+	update, err := owner.Client.UpdateCourseWithResponse(ctx, scheduled.ID, api.UpdateCourseJSONRequestBody{PublishAt: ptr(time.Now().Add(time.Hour))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "schedule course", update.StatusCode(), http.StatusOK)
+
+	// Ученик видит только опубликованные курсы, команда курса и админ — все
+	if got := listedCourses(t, student); !slices.Contains(got, published.ID) || slices.Contains(got, draft.ID) || slices.Contains(got, scheduled.ID) {
+		t.Fatalf("student sees %v, want published %s only", got, published.ID)
+	}
+	for name, s := range map[string]*apitest.Session{"owner": owner, "admin": admin} {
+		got := listedCourses(t, s)
+		for _, id := range []string{draft.ID, published.ID, scheduled.ID} {
+			if !slices.Contains(got, id) {
+				t.Fatalf("%s does not see course %s: %v", name, id, got)
+			}
+		}
+	}
+
+	// Курс с наступившим publish_at, но не опубликованный, ученику тоже не виден
+	if _, err := h.DB.Exec(ctx, "UPDATE courses SET publish_at = NOW() - INTERVAL '1 minute' WHERE id = $1", scheduled.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := listedCourses(t, student); slices.Contains(got, scheduled.ID) {
+		t.Fatalf("student sees approved course before it is published: %v", got)
+	}
+}
+
+func TestScheduledCoursePublishing(t *testing.T) {
+	h := apitest.New(t, func(cfg *config.Config) {
+		cfg.Scheduler.IntervalDur = 50 * time.Millisecond
+	})
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	admin := h.NewUser(models.RoleAdmin)
+	student := h.NewUser(models.RoleStudent)
+
+	course := createCourse(t, owner, true)
+	later, err := owner.Client.CreateLessonWithResponse(ctx, course.ID, course.SectionID, api.CreateLessonJSONRequestBody{
+		Title:     "Урок по расписанию",
+		Type:      api.LessonCreateTypeText,
+		Content:   ptr("# Позже"),
+		PublishAt: ptr(time.Now().Add(time.Hour)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "create scheduled lesson", later.StatusCode(), http.StatusCreated)
+	laterID := apitest.Data[models.Lesson](t, later.Body, "lesson").ID.String()
+
+	submit, err := owner.Client.SubmitCourseWithResponse(ctx, course.ID, api.CourseTransitionRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, submit.StatusCode(), submit.Body, http.StatusOK)
+	approve, err := admin.Client.ApproveCourseWithResponse(ctx, course.ID, api.CourseTransitionRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transition(t, approve.StatusCode(), approve.Body, http.StatusOK)
+
+	update, err := owner.Client.UpdateCourseWithResponse(ctx, course.ID, api.UpdateCourseJSONRequestBody{PublishAt: ptr(time.Now().Add(200 * time.Millisecond))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "schedule course", update.StatusCode(), http.StatusOK)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp, err := student.Client.GetCourseByIDWithResponse(ctx, course.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() == http.StatusOK {
+			got := apitest.Data[models.Course](t, resp.Body, "course")
+			if got.Status != models.CourseStatusPublished || got.PublishAt != nil {
+				t.Fatalf("scheduled course: %+v", got)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("course was not published by the scheduler: %d", resp.StatusCode())
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	enroll, err := student.Client.EnrollCourseWithResponse(ctx, course.ID, api.EnrollCourseJSONRequestBody{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "enroll", enroll.StatusCode(), http.StatusOK)
+
+	first, err := student.Client.GetLessonByIDWithResponse(ctx, course.ID, course.SectionID, course.LessonID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "published lesson", first.StatusCode(), http.StatusOK)
+
+	// Урок с publish_at в будущем попал в версию, но до срока ученикам не виден
+	hidden, err := student.Client.GetLessonByIDWithResponse(ctx, course.ID, course.SectionID, laterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "scheduled lesson", hidden.StatusCode(), http.StatusNotFound)
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"handbooks/internal/models"
 	storage "handbooks/pkg/storage"
 	"log/slog"
@@ -9,15 +10,41 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/gosimple/slug"
 	"github.com/huandu/go-sqlbuilder"
 )
 
 // CoursesGetSections implements [api.ServerInterface].
 func (s *Server) GetSections(w http.ResponseWriter, r *http.Request, courseID string) {
-	var ctx = r.Context()
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	course, err := storage.GetOne[models.Course](ctx, s.DB, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Course not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course by ID", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
 
-	sections, err := storage.GetAll[models.Section](ctx, "sections", s.DB)
+	manager, err := canManageCourse(ctx, s.DB, course, claims)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	// Ученики видят разделы опубликованной версии курса
+	content := contentFor(course, manager)
+	sections, err := storage.GetAll[models.Section](ctx, content.sections(), s.DB, func(sb *sqlbuilder.SelectBuilder) {
+		content.scope(sb)
+		sb.Where(sb.Equal("course_id", course.ID)).OrderBy(`"order"`)
+	})
 	if err != nil {
 		slog.ErrorContext(ctx, "Error getting sections", slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
@@ -40,19 +67,51 @@ func (s *Server) CreateSection(w http.ResponseWriter, r *http.Request, courseID
 		return
 	}
 
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	claims := ctx.Value("user").(*Claims)
+	course, ok := s.lockCourseForEdit(w, r, tx, courseID, claims)
+	if !ok {
+		return
+	}
+
 	time := time.Now()
 	section.ID = uuid.New()
-	section.Slug = slug.Make(section.Title)
-	section.CreatedID = ctx.Value("user").(*Claims).ID
+	section.CourseID = uuid.MustParse(course.ID)
+	section.CreatedID = claims.ID
 	section.CreatedAt = time
 	section.UpdatedAt = time
 
-	if err := storage.Create(ctx, "sections", section, s.DB); err != nil {
+	section.Slug, err = sectionSlugs.generate(ctx, tx, section.CourseID, section.Title, uuid.Nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error generating section slug", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	section.Order, err = reserveOrder(ctx, tx, "sections", "course_id", section.CourseID, section.Order)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error reserving section order", slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := storage.Create(ctx, "sections", section, tx); err != nil {
 		slog.ErrorContext(ctx, "Error creating section", slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
 	s.JSON(w, r, http.StatusCreated, section, "section")
 }
 
@@ -60,8 +119,25 @@ func (s *Server) CreateSection(w http.ResponseWriter, r *http.Request, courseID
 func (s *Server) DeleteSection(w http.ResponseWriter, r *http.Request, courseID string, sectionID string) {
 	var ctx = r.Context()
 
+	if _, ok := s.loadManagedCourse(w, r, courseID); !ok {
+		return
+	}
+
+	_, err := storage.GetOne[models.Section](ctx, s.DB, "sections", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", sectionID), sb.Equal("course_id", courseID))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Section not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting section by id", slog.String("id", sectionID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
 	if err := storage.Delete[models.Section](ctx, "sections", s.DB, func(sb *sqlbuilder.SelectBuilder) {
-		sb.Where(sb.Equal("id", sectionID))
+		sb.Where(sb.Equal("id", sectionID), sb.Equal("course_id", courseID))
 	}); err != nil {
 		slog.ErrorContext(ctx, "Error deleting section by ID", slog.String("error", err.Error()), slog.Any("ID", sectionID))
 		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
@@ -75,15 +151,49 @@ func (s *Server) DeleteSection(w http.ResponseWriter, r *http.Request, courseID
 func (s *Server) GetSectionByID(w http.ResponseWriter, r *http.Request, courseID string, sectionID string) {
 	ctx := r.Context()
 
-	section, err := storage.GetOne[models.Section](ctx, s.DB, "sections", func(sb *sqlbuilder.SelectBuilder) {
-		sb.Where(sb.Equal("id", sectionID))
+	course, err := storage.GetOne[models.Course](ctx, s.DB, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", courseID))
 	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Section not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course by ID", slog.String("id", courseID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.serveSection(w, r, course, sectionID)
+}
 
+// serveSection — отдаёт раздел курса; ученики получают его из опубликованной версии
+func (s *Server) serveSection(w http.ResponseWriter, r *http.Request, course *models.Course, sectionID string) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	manager, err := canManageCourse(ctx, s.DB, course, claims)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	content := contentFor(course, manager)
+	section, err := storage.GetOne[models.Section](ctx, s.DB, content.sections(), func(sb *sqlbuilder.SelectBuilder) {
+		content.scope(sb)
+		sb.Where(sb.Equal("id", sectionID), sb.Equal("course_id", course.ID))
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Section not found", "error")
+		return
+	}
 	if err != nil {
 		slog.ErrorContext(ctx, "Error getting section by id",
 			slog.String("id", sectionID),
 			slog.String("error", err.Error()))
-		s.JSON(w, r, http.StatusInternalServerError, nil, "internal server error")
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
@@ -93,17 +203,67 @@ func (s *Server) GetSectionByID(w http.ResponseWriter, r *http.Request, courseID
 // UpdateSection implements [api.ServerInterface].
 func (s *Server) UpdateSection(w http.ResponseWriter, r *http.Request, courseID string, sectionID string) {
 	var (
-		ctx     = r.Context()
-		section models.Section
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+		body   json.RawMessage
 	)
 
-	if err := json.NewDecoder(r.Body).Decode(&section); err != nil {
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		slog.ErrorContext(ctx, "Error decoding request body", slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
 		return
 	}
 
-	if err := storage.Update[models.Section](ctx, "sections", section, s.DB, func(sb *sqlbuilder.UpdateBuilder) {
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	if _, ok := s.lockCourseForEdit(w, r, tx, courseID, claims); !ok {
+		return
+	}
+
+	current, err := storage.GetOne[models.Section](ctx, tx, "sections", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", sectionID), sb.Equal("course_id", courseID)).ForUpdate()
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Section not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting section by id", slog.String("id", sectionID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	// PATCH: поля, которых нет в запросе, остаются прежними
+	section := *current
+	if err := json.Unmarshal(body, &section); err != nil {
+		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
+		return
+	}
+	section.ID, section.CourseID, section.CreatedID = current.ID, current.CourseID, current.CreatedID
+	section.Order, section.CreatedAt = current.Order, current.CreatedAt
+	section.UpdatedAt = time.Now()
+
+	// slug следует за названием; прежний остаётся в истории и отвечает редиректом
+	section.Slug = current.Slug
+	if section.Title != current.Title {
+		section.Slug, err = sectionSlugs.generate(ctx, tx, current.CourseID, section.Title, current.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "Error generating section slug", slog.String("error", err.Error()))
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+	}
+	if err := sectionSlugs.rename(ctx, tx, current.CourseID, current.ID, current.Slug, section.Slug); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if err := storage.Update[models.Section](ctx, "sections", section, tx, func(sb *sqlbuilder.UpdateBuilder) {
 		sb.Where(sb.Equal("id", sectionID))
 	}); err != nil {
 		slog.ErrorContext(ctx, "Error updating section", slog.String("error", err.Error()))
@@ -111,5 +271,10 @@ func (s *Server) UpdateSection(w http.ResponseWriter, r *http.Request, courseID
 		return
 	}
 
-	s.JSON(w, r, http.StatusCreated, section, "section")
+	if err := tx.Commit(ctx); err != nil {
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	s.JSON(w, r, http.StatusOK, section, "section")
 }
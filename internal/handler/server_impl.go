@@ -7,18 +7,25 @@ import (
 	"fmt"
 	"handbooks/internal/api"
 	"handbooks/internal/config"
+	"handbooks/internal/logger"
+	"handbooks/internal/metrics"
+	"handbooks/internal/tracing"
 	"log/slog"
 	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/xid"
 	slogchi "github.com/samber/slog-chi"
+	"go.opentelemetry.io/otel"
 )
 
 type ctxKey string
@@ -30,16 +37,23 @@ const (
 )
 
 type Server struct {
-	DB     *pgx.Conn
-	Config *config.Config
+	DB     *pgxpool.Pool
 	ctx    context.Context
 	Redis  *redis.Client
 	JwtKey []byte
-}
 
-// DeleteUserById implements [api.ServerInterface].
-func (s *Server) DeleteUserById(w http.ResponseWriter, r *http.Request, userId string) {
-	panic("unimplemented")
+	// cfg подменяется целиком при перезагрузке конфига (SIGHUP), см. Reload
+	cfg atomic.Pointer[config.Config]
+
+	oauthMu      sync.Mutex
+	oauthClients map[string]*oauthClient
+
+	// draining выставляется при остановке, чтобы /readyz отвечал 503
+	draining atomic.Bool
+
+	workersCtx  context.Context
+	stopWorkers context.CancelFunc
+	workers     sync.WaitGroup
 }
 
 var _ api.ServerInterface = (*Server)(nil)
@@ -50,75 +64,178 @@ type responseOptions struct {
 	requestID string
 }
 
-// NewServer - functions for return server object
-func NewServer(db *pgx.Conn, redis *redis.Client, config *config.Config) *Server {
-	return &Server{
+// NewServer - functions for return server object.
+// ctx — корневой контекст приложения: его отмена запускает остановку сервера.
+func NewServer(ctx context.Context, db *pgxpool.Pool, redis *redis.Client, config *config.Config) *Server {
+	workersCtx, stopWorkers := context.WithCancel(context.WithoutCancel(ctx))
+
+	s := &Server{
 		DB:     db,
 		Redis:  redis,
-		ctx:    context.Background(),
-		Config: config,
+		ctx:    ctx,
 		JwtKey: []byte(config.JwtOpt.Key),
+
+		oauthClients: make(map[string]*oauthClient),
+
+		workersCtx:  workersCtx,
+		stopWorkers: stopWorkers,
 	}
+	s.cfg.Store(config)
+
+	return s
+}
+
+// Config — текущая конфигурация. Значение не кэшируется: после Reload вернётся новая
+func (s *Server) Config() *config.Config {
+	return s.cfg.Load()
 }
 
 // Run - functions for run http Server with settings
 func (s *Server) Run() error {
+	srv := &http.Server{
+		Handler:      s.Handler(),
+		Addr:         s.Config().ServerURL(),
+		ReadTimeout:  s.Config().ReadTimeout(),
+		WriteTimeout: s.Config().WriteTimeout(),
+		IdleTimeout:  s.Config().IdleTimeout(),
+	}
+
+	if s.Config().Scheduler.IntervalDur > 0 {
+		s.goBackground("publish-scheduler", s.runPublishScheduler)
+	} else {
+		slog.Info("Планировщик публикаций отключён (scheduler.interval = 0)")
+	}
+
+	listenErr := make(chan error, 1)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			listenErr <- err
+		}
+	}()
+
+	slog.Info("Приложение запущено успешно 🚀", slog.String("URL", s.Config().ServerURL()))
+
+	select {
+	case <-s.ctx.Done():
+	case err := <-listenErr:
+		s.stopBackground(context.Background())
+		return fmt.Errorf("HTTP сервер упал: %w", err)
+	}
+
+	return s.shutdown(srv)
+}
+
+// Handler — роутер со всеми middleware и маршрутами API, без запуска сервера
+func (s *Server) Handler() http.Handler {
 	r := chi.NewMux()
 
 	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"https://*", "http://*"},
+		AllowOriginFunc:  s.originAllowed,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		ExposedHeaders:   []string{"Link", "X-Request-ID", "X-Trace-ID", "Retry-After", "RateLimit-Policy", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
 
+	// Request id и спан создаются до логгера: request_id и trace_id в строку access-лога
+	// добавляет обработчик из internal/logger
+	r.Use(s.MiddlewareRequestID)
+	r.Use(tracing.Middleware)
+
 	r.Use(slogchi.NewWithConfig(slog.Default(), slogchi.Config{
 		DefaultLevel:     slog.LevelInfo,
 		ClientErrorLevel: slog.LevelWarn,  // 400–499 → Warn
 		ServerErrorLevel: slog.LevelError, // 500+   → Error
-		WithRequestID:    true,            // берёт request-id из контекста
 		Filters: []slogchi.Filter{
-			slogchi.IgnorePath("/health", "/metrics", "/favicon.ico"),
+			slogchi.IgnorePath("/healthz", "/readyz", "/metrics", "/favicon.ico"),
 		},
 	}))
 
-	r.Use(s.MiddlewareRequestID)
-	r.Use(s.AuthMiddleware)
+	r.Use(metrics.Middleware)
 
-	h := api.HandlerFromMux(s, r)
+	r.Handle("/metrics", metrics.Handler())
+	r.Get("/healthz", s.Healthz)
+	r.Get("/readyz", s.Readyz)
 
-	srv := &http.Server{
-		Handler:      h,
-		Addr:         s.Config.ServerURL(),
-		ReadTimeout:  s.Config.ReadTimeout(),
-		WriteTimeout: s.Config.WriteTimeout(),
-		IdleTimeout:  s.Config.IdleTimeout(),
-	}
+	r.Group(func(r chi.Router) {
+		r.Use(s.RateLimitMiddleware)
+		r.Use(s.AuthMiddleware)
 
-	go func() {
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			slog.Error("HTTP сервер упал", "error", err)
-		}
-	}()
+		api.HandlerFromMux(s, r)
+	})
 
-	slog.Info("Приложение запущено успешно 🚀", slog.String("URL", s.Config.ServerURL()))
+	return r
+}
 
-	<-s.ctx.Done()
+// shutdown — остановка по порядку: /readyz начинает отвечать 503, сервер перестаёт
+// принимать соединения и дожидается активных запросов, затем останавливаются фоновые задачи.
+// Соединения с Redis и Postgres закрывает вызывающий код после возврата из Run.
+func (s *Server) shutdown(srv *http.Server) error {
+	s.draining.Store(true)
 
-	slog.Info("Остановка HTTP сервера...")
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	if delay := s.Config().Server.DrainDelayDur; delay > 0 {
+		slog.Info("Ожидание снятия трафика перед остановкой", slog.Duration("delay", delay))
+		time.Sleep(delay)
+	}
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), s.Config().Server.ShutdownTimeoutDur)
 	defer shutdownCancel()
 
-	return srv.Shutdown(shutdownCtx)
+	slog.Info("Остановка HTTP сервера...")
+	httpErr := srv.Shutdown(shutdownCtx)
+	if httpErr != nil {
+		slog.Error("Не все запросы завершились до таймаута", "error", httpErr)
+	}
+
+	slog.Info("Остановка фоновых задач...")
+	workersErr := s.stopBackground(shutdownCtx)
+	if workersErr != nil {
+		slog.Error("Не все фоновые задачи завершились до таймаута", "error", workersErr)
+	}
+
+	return errors.Join(httpErr, workersErr)
+}
+
+// Close — останавливает фоновые задачи сервера, запущенного не через Run, а через Handler
+// (например, в тестах). Соединения с БД и Redis не закрывает.
+func (s *Server) Close(ctx context.Context) error {
+	return s.stopBackground(ctx)
+}
+
+// goBackground — запускает фоновую задачу, которую сервер дождётся при остановке.
+// Контекст задачи отменяется после того, как HTTP-сервер закончит обработку запросов.
+func (s *Server) goBackground(name string, fn func(ctx context.Context)) {
+	s.workers.Go(func() {
+		slog.Debug("Фоновая задача запущена", slog.String("worker", name))
+		fn(s.workersCtx)
+		slog.Debug("Фоновая задача остановлена", slog.String("worker", name))
+	})
+}
+
+// stopBackground — отменяет контекст фоновых задач и ждёт их завершения в пределах ctx
+func (s *Server) stopBackground(ctx context.Context) error {
+	s.stopWorkers()
+
+	done := make(chan struct{})
+	go func() {
+		s.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // === Middlewares ===
 
 func (s *Server) AuthMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == "/auth/register" || r.URL.Path == "/auth/login" {
+		if isPublicPath(r.URL.Path) {
 			next.ServeHTTP(w, r)
 			return
 		}
@@ -129,7 +246,7 @@ func (s *Server) AuthMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		slog.Info("Проверка авторизации", slog.String("Token", tokenStr))
+		slog.DebugContext(r.Context(), "Проверка авторизации", slog.String("token", tokenStr))
 
 		claims, err := s.validateAccessToken(r.Context(), tokenStr)
 		if err != nil {
@@ -145,6 +262,7 @@ func (s *Server) AuthMiddleware(next http.Handler) http.Handler {
 		}
 
 		ctx := context.WithValue(r.Context(), "user", claims)
+		ctx = logger.WithUserID(ctx, claims.ID.String())
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -157,6 +275,7 @@ func (s *Server) MiddlewareRequestID(next http.Handler) http.Handler {
 		}
 
 		ctx := context.WithValue(r.Context(), requestIDKey, rid)
+		ctx = logger.WithRequestID(ctx, rid)
 
 		w.Header().Set("X-Request-ID", rid)
 
@@ -185,6 +304,9 @@ func (s *Server) JSON(w http.ResponseWriter, r *http.Request, status int, payloa
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(status)
 
+	_, span := otel.Tracer("handbooks/http").Start(r.Context(), "json.encode")
+	defer span.End()
+
 	if err := json.NewEncoder(w).Encode(resp); err != nil {
 		slog.Error("json encode failed after headers written",
 			slog.Int("status", status),
@@ -236,6 +358,15 @@ func (s *Server) validateAccessToken(ctx context.Context, tokenStr string) (*Cla
 
 // === Private functions ===
 
+// isPublicPath — маршруты, доступные без access-токена
+func isPublicPath(path string) bool {
+	switch path {
+	case "/auth/register", "/auth/login", "/auth/2fa":
+		return true
+	}
+	return strings.HasPrefix(path, "/auth/oauth/")
+}
+
 func extractToken(r *http.Request) string {
 	if r == nil {
 		return ""
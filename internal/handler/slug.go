@@ -0,0 +1,343 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+
+	"github.com/google/uuid"
+	"github.com/gosimple/slug"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+)
+
+// maxSlugLength — длина slug без суффикса; колонки slug — VARCHAR(120)
+const maxSlugLength = 100
+
+// ErrSlugTaken — явно заданный slug уже занят другой записью или чьим-то прежним slug
+var ErrSlugTaken = errors.New("slug already taken")
+
+// slugScope — таблица, в которой slug уникален в пределах родителя, и история её прежних slug
+type slugScope struct {
+	table string
+	// parentColumn — колонка родителя; пусто, если slug уникален во всей таблице
+	parentColumn string
+}
+
+var (
+	courseSlugs  = slugScope{table: "courses"}
+	sectionSlugs = slugScope{table: "sections", parentColumn: "course_id"}
+	lessonSlugs  = slugScope{table: "lessons", parentColumn: "section_id"}
+)
+
+// generate — свободный slug из base: slug.Make(base), а если он занят — с суффиксом -2, -3, ...
+// Занятыми считаются slug других записей родителя и их прежние slug, чтобы старые ссылки
+// не начали вести на чужую запись. self — id записи, которой подбирается slug (uuid.Nil для новой).
+// Блокировка на родителя до конца транзакции исключает гонку двух одинаковых названий.
+func (sc slugScope) generate(ctx context.Context, tx pgx.Tx, parentID uuid.UUID, base string, self uuid.UUID) (string, error) {
+	candidate := slug.Make(base)
+	if len(candidate) > maxSlugLength {
+		candidate = strings.TrimRight(candidate[:maxSlugLength], "-")
+	}
+	if candidate == "" {
+		candidate = "untitled"
+	}
+
+	if err := sc.lock(ctx, tx, parentID); err != nil {
+		return "", err
+	}
+
+	taken, err := sc.taken(ctx, tx, parentID, candidate, self, true)
+	if err != nil {
+		return "", err
+	}
+	if !taken[candidate] {
+		return candidate, nil
+	}
+	for i := 2; ; i++ {
+		if next := candidate + "-" + strconv.Itoa(i); !taken[next] {
+			return next, nil
+		}
+	}
+}
+
+// claim — проверяет, что явно заданный slug свободен, и возвращает его в нормализованном виде
+func (sc slugScope) claim(ctx context.Context, tx pgx.Tx, parentID uuid.UUID, requested string, self uuid.UUID) (string, error) {
+	candidate := slug.Make(requested)
+	if candidate == "" || len(candidate) > maxSlugLength {
+		return "", ErrSlugTaken
+	}
+
+	if err := sc.lock(ctx, tx, parentID); err != nil {
+		return "", err
+	}
+
+	taken, err := sc.taken(ctx, tx, parentID, candidate, self, false)
+	if err != nil {
+		return "", err
+	}
+	if taken[candidate] {
+		return "", ErrSlugTaken
+	}
+	return candidate, nil
+}
+
+// rename — запоминает прежний slug записи, чтобы по нему отдавать редирект.
+// Если запись возвращает себе один из своих прежних slug, он убирается из истории
+func (sc slugScope) rename(ctx context.Context, tx pgx.Tx, parentID, id uuid.UUID, oldSlug, newSlug string) error {
+	if oldSlug == newSlug || oldSlug == "" {
+		return nil
+	}
+
+	if err := storage.Delete[models.SlugRedirect](ctx, "slug_history", tx, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(
+			sb.Equal("entity", sc.table),
+			sb.Equal("scope_id", parentID),
+			sb.Equal("slug", newSlug),
+			sb.Equal("target_id", id),
+		)
+	}); err != nil {
+		return err
+	}
+
+	ib := sqlbuilder.NewStruct(new(models.SlugRedirect)).For(sqlbuilder.PostgreSQL).InsertInto("slug_history", models.SlugRedirect{
+		ID:       uuid.New(),
+		Entity:   sc.table,
+		ScopeID:  parentID,
+		Slug:     oldSlug,
+		TargetID: id,
+	})
+	ib.SQL("ON CONFLICT (entity, scope_id, slug) DO UPDATE SET target_id = EXCLUDED.target_id, created_at = NOW()")
+	query, args := ib.Build()
+
+	_, err := tx.Exec(ctx, query, args...)
+	return err
+}
+
+// resolve — id записи по текущему или прежнему slug. redirected == true, если slug прежний
+func (sc slugScope) resolve(ctx context.Context, db storage.Querier, parentID uuid.UUID, value string) (id uuid.UUID, redirected bool, err error) {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("id").From(sc.table).Where(sb.Equal("slug", value))
+	if sc.parentColumn != "" {
+		sb.Where(sb.Equal(sc.parentColumn, parentID))
+	}
+	query, args := sb.Build()
+
+	err = db.QueryRow(ctx, query, args...).Scan(&id)
+	if err == nil {
+		return id, false, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, false, err
+	}
+
+	redirect, err := storage.GetOne[models.SlugRedirect](ctx, db, "slug_history", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("entity", sc.table), sb.Equal("scope_id", parentID), sb.Equal("slug", value))
+	})
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+
+	return redirect.TargetID, true, nil
+}
+
+func (sc slugScope) lock(ctx context.Context, tx pgx.Tx, parentID uuid.UUID) error {
+	_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "slug:"+sc.table+":"+parentID.String())
+	return err
+}
+
+// taken — какие из slug вида candidate и candidate-N заняты в пределах родителя, кроме записи self.
+// withSuffixes == false — проверяется только сам candidate
+func (sc slugScope) taken(ctx context.Context, db storage.Querier, parentID uuid.UUID, candidate string, self uuid.UUID, withSuffixes bool) (map[string]bool, error) {
+	match := func(cond *sqlbuilder.Cond, column string) string {
+		if withSuffixes {
+			return cond.Or(cond.Equal(column, candidate), cond.Like(column, candidate+"-%"))
+		}
+		return cond.Equal(column, candidate)
+	}
+
+	current := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	current.Select("slug").From(sc.table).Where(match(&current.Cond, "slug"), current.NotEqual("id", self))
+	if sc.parentColumn != "" {
+		current.Where(current.Equal(sc.parentColumn, parentID))
+	}
+
+	history := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	history.Select("slug").From("slug_history").Where(
+		match(&history.Cond, "slug"),
+		history.Equal("entity", sc.table),
+		history.Equal("scope_id", parentID),
+		history.NotEqual("target_id", self),
+	)
+
+	query, args := sqlbuilder.PostgreSQL.NewUnionBuilder().UnionAll(current, history).Build()
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, err
+	}
+
+	taken := make(map[string]bool, len(existing))
+	for _, s := range existing {
+		taken[s] = true
+	}
+	return taken, nil
+}
+
+// redirectToSlug — 301 на канонический адрес записи. Адрес дублируется в теле
+// для клиентов, которые не следуют редиректам сами
+func (s *Server) redirectToSlug(w http.ResponseWriter, r *http.Request, location string) {
+	if r.URL.RawQuery != "" {
+		location += "?" + r.URL.RawQuery
+	}
+	w.Header().Set("Location", location)
+	s.JSON(w, r, http.StatusMovedPermanently, location, "location")
+}
+
+// GetCourseBySlug implements [api.ServerInterface].
+func (s *Server) GetCourseBySlug(w http.ResponseWriter, r *http.Request, courseSlug string) {
+	ctx := r.Context()
+
+	course, redirected, err := s.courseBySlug(ctx, courseSlug)
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Course not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting course by slug", slog.String("slug", courseSlug), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if redirected {
+		s.redirectToSlug(w, r, "/courses/by-slug/"+course.Slug)
+		return
+	}
+
+	s.serveCourse(w, r, course)
+}
+
+// GetSectionBySlug implements [api.ServerInterface].
+func (s *Server) GetSectionBySlug(w http.ResponseWriter, r *http.Request, courseSlug string, sectionSlug string) {
+	ctx := r.Context()
+
+	course, section, redirected, err := s.sectionBySlug(ctx, courseSlug, sectionSlug)
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Section not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting section by slug", slog.String("slug", sectionSlug), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if redirected {
+		s.redirectToSlug(w, r, "/courses/by-slug/"+course.Slug+"/sections/"+section.Slug)
+		return
+	}
+
+	s.serveSection(w, r, course, section.ID.String())
+}
+
+// GetLessonBySlug implements [api.ServerInterface].
+func (s *Server) GetLessonBySlug(w http.ResponseWriter, r *http.Request, courseSlug string, sectionSlug string, lessonSlug string) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	course, section, redirected, err := s.sectionBySlug(ctx, courseSlug, sectionSlug)
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Lesson not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting section by slug", slog.String("slug", sectionSlug), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	// Урок ищется в указанном разделе; после переноса его прежний slug остаётся в истории старого раздела
+	lessonID, lessonRedirected, err := lessonSlugs.resolve(ctx, s.DB, section.ID, lessonSlug)
+	var lesson *models.Lesson
+	if err == nil {
+		lesson, err = storage.GetOne[models.Lesson](ctx, s.DB, "lessons", func(sb *sqlbuilder.SelectBuilder) {
+			sb.Where(sb.Equal("id", lessonID), sb.Equal("course_id", course.ID))
+		})
+	}
+	if errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "Lesson not found", "error")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting lesson by slug", slog.String("slug", lessonSlug), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+
+	if redirected || lessonRedirected || lesson.SectionID != section.ID {
+		if lesson.SectionID != section.ID {
+			section, err = storage.GetOne[models.Section](ctx, s.DB, "sections", func(sb *sqlbuilder.SelectBuilder) {
+				sb.Where(sb.Equal("id", lesson.SectionID))
+			})
+			if err != nil {
+				slog.ErrorContext(ctx, "Error getting section by id", slog.String("id", lesson.SectionID.String()), slog.String("error", err.Error()))
+				s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+				return
+			}
+		}
+		s.redirectToSlug(w, r, "/courses/by-slug/"+course.Slug+"/sections/"+section.Slug+"/lessons/"+lesson.Slug)
+		return
+	}
+
+	// Адрес разрешается по рабочей копии, а содержимое ученик получает из опубликованной версии
+	s.serveLesson(w, r, course, lesson.ID.String(), "", claims)
+}
+
+// courseBySlug — курс по текущему или прежнему slug; redirected == true, если slug прежний
+func (s *Server) courseBySlug(ctx context.Context, value string) (*models.Course, bool, error) {
+	id, redirected, err := courseSlugs.resolve(ctx, s.DB, uuid.Nil, value)
+	if err != nil {
+		return nil, false, err
+	}
+
+	course, err := storage.GetOne[models.Course](ctx, s.DB, "courses", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", id))
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return course, redirected, nil
+}
+
+// sectionBySlug — курс и его раздел по slug; redirected == true, если хотя бы один slug прежний
+func (s *Server) sectionBySlug(ctx context.Context, courseSlug, sectionSlug string) (*models.Course, *models.Section, bool, error) {
+	course, courseRedirected, err := s.courseBySlug(ctx, courseSlug)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	id, sectionRedirected, err := sectionSlugs.resolve(ctx, s.DB, uuid.MustParse(course.ID), sectionSlug)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	section, err := storage.GetOne[models.Section](ctx, s.DB, "sections", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", id), sb.Equal("course_id", course.ID))
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return course, section, courseRedirected || sectionRedirected, nil
+}
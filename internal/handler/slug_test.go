@@ -0,0 +1,260 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"handbooks/internal/api"
+	"handbooks/internal/apitest"
+	"handbooks/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// newCourse — курс с заданным названием от имени s
+func newCourse(t *testing.T, s *apitest.Session, title string) models.Course {
+	t.Helper()
+	ctx := context.Background()
+
+	resp, err := s.Client.CreateCourseWithResponse(ctx, api.CreateCourseJSONRequestBody{Title: title})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "create course", resp.StatusCode(), http.StatusCreated)
+
+	course, err := s.Client.GetCourseByIDWithResponse(ctx, apitest.Data[string](t, resp.Body, "course"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "get course", course.StatusCode(), http.StatusOK)
+	return apitest.Data[models.Course](t, course.Body, "course")
+}
+
+// newSection — раздел курса с заданным названием
+func newSection(t *testing.T, s *apitest.Session, courseID, title string) models.Section {
+	t.Helper()
+
+	resp, err := s.Client.CreateSectionWithResponse(context.Background(), courseID, api.CreateSectionJSONRequestBody{Title: title})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "create section", resp.StatusCode(), http.StatusCreated)
+	return apitest.Data[models.Section](t, resp.Body, "section")
+}
+
+// newLesson — текстовый урок раздела с заданным названием
+func newLesson(t *testing.T, s *apitest.Session, courseID, sectionID, title string) models.Lesson {
+	t.Helper()
+
+	resp, err := s.Client.CreateLessonWithResponse(context.Background(), courseID, sectionID, api.CreateLessonJSONRequestBody{
+		Title:   title,
+		Type:    api.LessonCreateTypeText,
+		Content: ptr("# " + title),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "create lesson", resp.StatusCode(), http.StatusCreated)
+	return apitest.Data[models.Lesson](t, resp.Body, "lesson")
+}
+
+// slugRedirect — запрос req от имени s без следования редиректу; возвращает Location ответа 301
+func slugRedirect(t *testing.T, h *apitest.Harness, s *apitest.Session, req *http.Request) string {
+	t.Helper()
+
+	client := *h.HTTP.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+
+	req.Header.Set("Authorization", s.Token)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	expectStatus(t, req.URL.Path, resp.StatusCode, http.StatusMovedPermanently)
+
+	return resp.Header.Get("Location")
+}
+
+func TestSlugGeneration(t *testing.T) {
+	h := apitest.New(t)
+	owner := h.NewUser(models.RoleInstructor)
+
+	t.Run("collision gets a suffix", func(t *testing.T) {
+		title := "Slug " + uuid.NewString()
+		first := newCourse(t, owner, title)
+		second := newCourse(t, owner, title)
+		third := newCourse(t, owner, title)
+
+		if second.Slug != first.Slug+"-2" || third.Slug != first.Slug+"-3" {
+			t.Fatalf("slugs %q, %q, %q", first.Slug, second.Slug, third.Slug)
+		}
+	})
+
+	t.Run("empty slug falls back", func(t *testing.T) {
+		course := newCourse(t, owner, "!!!")
+		if !strings.HasPrefix(course.Slug, "untitled") {
+			t.Fatalf("slug %q", course.Slug)
+		}
+	})
+
+	t.Run("sections are scoped by course", func(t *testing.T) {
+		one := newCourse(t, owner, "Scope "+uuid.NewString())
+		two := newCourse(t, owner, "Scope "+uuid.NewString())
+
+		a := newSection(t, owner, one.ID, "Введение")
+		b := newSection(t, owner, two.ID, "Введение")
+		c := newSection(t, owner, one.ID, "Введение")
+
+		if a.Slug != b.Slug {
+			t.Fatalf("same title in different courses: %q and %q", a.Slug, b.Slug)
+		}
+		if c.Slug != a.Slug+"-2" {
+			t.Fatalf("same title in one course: %q and %q", a.Slug, c.Slug)
+		}
+	})
+
+	t.Run("lessons are scoped by section", func(t *testing.T) {
+		course := newCourse(t, owner, "Scope "+uuid.NewString())
+		one := newSection(t, owner, course.ID, "Первый раздел")
+		two := newSection(t, owner, course.ID, "Второй раздел")
+
+		a := newLesson(t, owner, course.ID, one.ID.String(), "Урок")
+		b := newLesson(t, owner, course.ID, two.ID.String(), "Урок")
+		c := newLesson(t, owner, course.ID, one.ID.String(), "Урок")
+
+		if a.Slug != b.Slug {
+			t.Fatalf("same title in different sections: %q and %q", a.Slug, b.Slug)
+		}
+		if c.Slug != a.Slug+"-2" {
+			t.Fatalf("same title in one section: %q and %q", a.Slug, c.Slug)
+		}
+	})
+}
+
+func TestSlugRename(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+	owner := h.NewUser(models.RoleInstructor)
+
+	title := "Rename " + uuid.NewString()
+	course := newCourse(t, owner, title)
+	oldSlug := course.Slug
+
+	update, err := owner.Client.UpdateCourseWithResponse(ctx, course.ID, api.UpdateCourseJSONRequestBody{Title: ptr("Renamed " + uuid.NewString())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "rename course", update.StatusCode(), http.StatusOK)
+	renamed := apitest.Data[models.Course](t, update.Body, "course")
+	if renamed.Slug == oldSlug {
+		t.Fatalf("slug did not follow the title: %q", renamed.Slug)
+	}
+
+	t.Run("old course slug redirects", func(t *testing.T) {
+		req, err := api.NewGetCourseBySlugRequest(h.HTTP.URL, oldSlug)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := slugRedirect(t, h, owner, req), "/courses/by-slug/"+renamed.Slug; got != want {
+			t.Fatalf("Location = %q, want %q", got, want)
+		}
+
+		resp, err := owner.Client.GetCourseBySlugWithResponse(ctx, oldSlug)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "follow redirect", resp.StatusCode(), http.StatusOK)
+		if got := apitest.Data[models.Course](t, resp.Body, "course"); got.ID != course.ID {
+			t.Fatalf("old slug leads to %s, want %s", got.ID, course.ID)
+		}
+	})
+
+	t.Run("old slug is not reused", func(t *testing.T) {
+		// Новый курс с прежним названием не должен перехватить старые ссылки
+		other := newCourse(t, owner, title)
+		if other.Slug == oldSlug {
+			t.Fatalf("old slug %q was given to another course", oldSlug)
+		}
+
+		taken, err := owner.Client.UpdateCourseWithResponse(ctx, other.ID, api.UpdateCourseJSONRequestBody{Slug: ptr(oldSlug)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "claim old slug", taken.StatusCode(), http.StatusConflict)
+	})
+
+	t.Run("explicit slug", func(t *testing.T) {
+		other := newCourse(t, owner, "Explicit "+uuid.NewString())
+
+		taken, err := owner.Client.UpdateCourseWithResponse(ctx, other.ID, api.UpdateCourseJSONRequestBody{Slug: ptr(renamed.Slug)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "claim current slug", taken.StatusCode(), http.StatusConflict)
+
+		wanted := "explicit-" + uuid.NewString()
+		claimed, err := owner.Client.UpdateCourseWithResponse(ctx, other.ID, api.UpdateCourseJSONRequestBody{Slug: ptr(wanted)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "claim free slug", claimed.StatusCode(), http.StatusOK)
+		if got := apitest.Data[models.Course](t, claimed.Body, "course").Slug; got != wanted {
+			t.Fatalf("slug %q, want %q", got, wanted)
+		}
+
+		// Запись может вернуть себе свой прежний slug
+		back, err := owner.Client.UpdateCourseWithResponse(ctx, course.ID, api.UpdateCourseJSONRequestBody{Slug: ptr(oldSlug)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "reclaim own slug", back.StatusCode(), http.StatusOK)
+		renamed = apitest.Data[models.Course](t, back.Body, "course")
+		if renamed.Slug != oldSlug {
+			t.Fatalf("slug %q, want %q", renamed.Slug, oldSlug)
+		}
+	})
+
+	t.Run("old section and lesson slugs redirect", func(t *testing.T) {
+		section := newSection(t, owner, course.ID, "Раздел")
+		lesson := newLesson(t, owner, course.ID, section.ID.String(), "Урок")
+
+		updateSection, err := owner.Client.UpdateSectionWithResponse(ctx, course.ID, section.ID.String(), api.UpdateSectionJSONRequestBody{Title: ptr("Другой раздел")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "rename section", updateSection.StatusCode(), http.StatusOK)
+		newSectionSlug := apitest.Data[models.Section](t, updateSection.Body, "section").Slug
+
+		updateLesson, err := owner.Client.UpdateLessonWithResponse(ctx, course.ID, section.ID.String(), lesson.ID.String(), api.UpdateLessonJSONRequestBody{Title: ptr("Другой урок")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "rename lesson", updateLesson.StatusCode(), http.StatusOK)
+		newLessonSlug := apitest.Data[models.Lesson](t, updateLesson.Body, "lesson").Slug
+
+		req, err := api.NewGetSectionBySlugRequest(h.HTTP.URL, renamed.Slug, section.Slug)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := slugRedirect(t, h, owner, req), "/courses/by-slug/"+renamed.Slug+"/sections/"+newSectionSlug; got != want {
+			t.Fatalf("section Location = %q, want %q", got, want)
+		}
+
+		req, err = api.NewGetLessonBySlugRequest(h.HTTP.URL, renamed.Slug, newSectionSlug, lesson.Slug)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := slugRedirect(t, h, owner, req), "/courses/by-slug/"+renamed.Slug+"/sections/"+newSectionSlug+"/lessons/"+newLessonSlug; got != want {
+			t.Fatalf("lesson Location = %q, want %q", got, want)
+		}
+
+		current, err := owner.Client.GetLessonBySlugWithResponse(ctx, renamed.Slug, newSectionSlug, newLessonSlug)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "current lesson slug", current.StatusCode(), http.StatusOK)
+	})
+}
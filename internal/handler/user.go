@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
@@ -9,8 +10,10 @@ import (
 	"net/http"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"handbooks/internal/api"
+	"handbooks/internal/metrics"
 	"handbooks/internal/models"
 	storage "handbooks/pkg/storage"
 
@@ -41,23 +44,83 @@ func (s *Server) AuthLoginUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ip := clientIP(r)
+
+	remaining, err := s.loginLockRemaining(ctx, loginSubjects(req.Email, ip))
+	if err != nil {
+		slog.ErrorContext(ctx, "redis login lock check failed", "err", err)
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
+	if remaining > 0 {
+		s.tooManyLoginAttempts(w, r, remaining)
+		return
+	}
+
 	user, err := storage.GetOne[models.User](ctx, s.DB, "users", func(sb *sqlbuilder.SelectBuilder) {
 		sb.Where(sb.Equal("email", req.Email))
 	})
 	if err != nil {
 		slog.WarnContext(ctx, "user not found or db error", "email", req.Email, "err", err)
+		if s.loginFailed(w, r, loginSubjects(req.Email, ip)) {
+			return
+		}
 		s.JSON(w, r, http.StatusUnauthorized, "пользователь по email не найден", "error")
 		return
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+		if s.loginFailed(w, r, loginSubjects(req.Email, ip)) {
+			return
+		}
 		s.JSON(w, r, http.StatusUnauthorized, "Неверный пароль", "error")
 		return
 	}
 
+	if err := s.resetLoginFailures(ctx, emailSubject(req.Email)); err != nil {
+		slog.ErrorContext(ctx, "redis reset login failures failed", "err", err)
+	}
+
+	if user.TotpEnabled {
+		s.issueMfaChallenge(w, r, user)
+		return
+	}
+
+	metrics.Logins.WithLabelValues("password").Inc()
+	s.touchLastLogin(ctx, user)
 	s.issueTokens(w, r, user)
 }
 
+// touchLastLogin — записывает время последнего входа пользователя
+func (s *Server) touchLastLogin(ctx context.Context, user *models.User) {
+	now := time.Now()
+	if err := storage.UpdateFields(ctx, "users", map[string]any{"last_login_at": now}, s.DB, func(ub *sqlbuilder.UpdateBuilder) {
+		ub.Where(ub.Equal("id", user.ID))
+	}); err != nil {
+		slog.ErrorContext(ctx, "Error updating last login", slog.String("error", err.Error()))
+		return
+	}
+	user.LastLoginAt = &now
+}
+
+// loginFailed — учитывает неудачный вход; если один из субъектов заблокирован,
+// сам отвечает 429 и возвращает true
+func (s *Server) loginFailed(w http.ResponseWriter, r *http.Request, subjects []string) bool {
+	metrics.LoginFailures.Inc()
+
+	locked, err := s.registerLoginFailure(r.Context(), subjects)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "redis register login failure failed", "err", err)
+		return false
+	}
+	if locked > 0 {
+		slog.WarnContext(r.Context(), "login locked", "subjects", subjects, "duration", locked)
+		s.tooManyLoginAttempts(w, r, locked)
+		return true
+	}
+	return false
+}
+
 // AuthRegisterUser — регистрация + сразу логин (токены)
 func (s *Server) AuthRegisterUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -91,6 +154,7 @@ func (s *Server) AuthRegisterUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	metrics.Registrations.Inc()
 	s.issueTokens(w, r, &user)
 }
 
@@ -127,9 +191,7 @@ func (s *Server) AuthRefreshToken(w http.ResponseWriter, r *http.Request) {
 	if _, err := s.Redis.Get(ctx, key).Result(); err == redis.Nil {
 		s.JSON(w, r, http.StatusUnauthorized, "No active refresh token", "error")
 		return
-	}
-
-	if err != nil {
+	} else if err != nil {
 		slog.ErrorContext(ctx, "redis get error", "err", err)
 		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
 		return
@@ -144,68 +206,141 @@ func (s *Server) AuthRefreshToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	newAccess, err := s.generateAccessToken(user, s.Config.RedisAccessTokenDur())
-	if err != nil {
-		s.JSON(w, r, http.StatusInternalServerError, "Token generation error", "error")
+	// Старый refresh одноразовый: удаляем до выдачи новой пары
+	if err := s.Redis.Del(ctx, key).Err(); err != nil {
+		slog.ErrorContext(ctx, "redis del old refresh failed", "err", err)
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
 		return
 	}
 
-	newRefresh, err := s.generateRefreshToken()
-	if err != nil {
-		s.JSON(w, r, http.StatusInternalServerError, "Token generation error", "error")
+	s.issueTokens(w, r, user)
+}
+
+// DeleteUserById implements [api.ServerInterface].
+// Удалять чужие аккаунты может только администратор; выданные пользователю токены отзываются
+func (s *Server) DeleteUserById(w http.ResponseWriter, r *http.Request, userID string) {
+	var (
+		ctx    = r.Context()
+		claims = ctx.Value("user").(*Claims)
+	)
+
+	if claims.Role != models.RoleAdmin {
+		s.JSON(w, r, http.StatusForbidden, "Forbidden", "error")
 		return
 	}
 
-	if err := s.Redis.Del(ctx, key).Err(); err != nil {
-		slog.ErrorContext(ctx, "redis del old refresh failed", "err", err)
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		s.JSON(w, r, http.StatusNotFound, "User not found", "error")
+		return
 	}
 
-	if err := s.Redis.Set(ctx, key, "valid", s.Config.RedisRefreshTokenDur()).Err(); err != nil {
-		slog.ErrorContext(ctx, "redis set new refresh failed", "err", err)
-		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+	if _, err := storage.GetOne[models.User](ctx, s.DB, "users", func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", id))
+	}); errors.Is(err, storage.ErrNotFound) {
+		s.JSON(w, r, http.StatusNotFound, "User not found", "error")
+		return
+	} else if err != nil {
+		slog.ErrorContext(ctx, "Error getting user by id", slog.String("error", err.Error()), slog.Any("ID", userID))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
-	access := "access_hash:" + newAccess
-	if err := s.Redis.Set(ctx, access, "valid", s.Config.RedisAccessTokenDur()).Err(); err != nil {
-		slog.ErrorContext(ctx, "redis set new access failed", "err", err)
-		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+	if err := storage.Delete[models.User](ctx, "users", s.DB, func(sb *sqlbuilder.SelectBuilder) {
+		sb.Where(sb.Equal("id", id))
+	}); err != nil {
+		slog.ErrorContext(ctx, "Error deleting user by id", slog.String("error", err.Error()), slog.Any("ID", userID))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    newRefresh,
-		HttpOnly: true,
-		Secure:   true,
-		SameSite: http.SameSiteStrictMode,
-		Path:     "/",
-		MaxAge:   int(s.Config.RedisRefreshTokenDur()),
-	})
+	if err := s.revokeUserTokens(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Error revoking user tokens", slog.String("error", err.Error()), slog.Any("ID", userID))
+	}
 
-	s.JSON(w, r, http.StatusOK, map[string]any{
-		"access_token": newAccess,
-		"expires_in":   86400, // 24 часа
-	}, "auth")
+	s.JSON(w, r, http.StatusOK, true, "user")
 }
 
-// UsersDeleteById implements [api.ServerInterface].
-func (s *Server) DeleteUser(w http.ResponseWriter, r *http.Request, userID string) {
-	var ctx = r.Context()
+// UsersDeleteCurrent implements [api.ServerInterface].
+// Удаление подтверждается паролем, кодом 2FA или повторным входом через привязанного провайдера
+// (у аккаунтов, созданных через OAuth, пароля нет). Курсы, разделы и уроки пользователя остаются,
+// но теряют автора (created_id обнуляется внешним ключом ON DELETE SET NULL).
+func (s *Server) DeleteCurrentUser(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx = r.Context()
+		req api.UserDeleteRequest
+	)
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || (req.Password == nil && req.Code == nil && req.ReauthToken == nil) {
+		s.JSON(w, r, http.StatusBadRequest, "Password, 2FA code or reauth token required", "error")
+		return
+	}
+
+	user, ok := s.currentUser(w, r)
+	if !ok {
+		return
+	}
+	userID := user.ID
+
+	confirmed, err := s.confirmAccountOwner(ctx, user, req)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error confirming account deletion", slog.Any("ID", userID), slog.String("error", err.Error()))
+		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+		return
+	}
+	if !confirmed {
+		s.JSON(w, r, http.StatusBadRequest, "Неверное подтверждение", "error")
+		return
+	}
 
 	if err := storage.Delete[models.User](ctx, "users", s.DB, func(sb *sqlbuilder.SelectBuilder) {
 		sb.Where(sb.Equal("id", userID))
 	}); err != nil {
-		slog.ErrorContext(ctx, "Error deleting user by id", slog.String("error", err.Error()), slog.Any("ID", userID))
+		slog.ErrorContext(ctx, "Error deleting user by ID", slog.String("error", err.Error()), slog.Any("ID", userID))
 		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
+	if err := s.revokeUserTokens(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "Error revoking user tokens", slog.String("error", err.Error()), slog.Any("ID", userID))
+	}
+
+	s.deleteRefreshCookie(w)
 	s.JSON(w, r, http.StatusOK, true, "user")
 }
 
-// UsersDeleteCurrent implements [api.ServerInterface].
-func (s *Server) DeleteCurrentUser(w http.ResponseWriter, r *http.Request) {
+// confirmAccountOwner — проверяет переданное подтверждение: пароль (если он задан),
+// код 2FA (если 2FA включена) или одноразовый reauth-токен, выданный этому же пользователю
+func (s *Server) confirmAccountOwner(ctx context.Context, user *models.User, req api.UserDeleteRequest) (bool, error) {
+	if req.Password != nil && user.PasswordHash != "" &&
+		bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(*req.Password)) == nil {
+		return true, nil
+	}
+
+	if req.Code != nil && user.TotpEnabled {
+		valid, err := s.checkSecondFactor(ctx, user, *req.Code)
+		if err != nil || valid {
+			return valid, err
+		}
+	}
+
+	if req.ReauthToken != nil && *req.ReauthToken != "" {
+		userID, err := s.Redis.GetDel(ctx, "oauth_reauth:"+*req.ReauthToken).Result()
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return userID == user.ID.String(), nil
+	}
+
+	return false, nil
+}
+
+// ExportCurrentUser implements [api.ServerInterface].
+// Отдаёт все данные пользователя одним JSON-файлом для скачивания.
+func (s *Server) ExportCurrentUser(w http.ResponseWriter, r *http.Request) {
 	var (
 		ctx = r.Context()
 	)
@@ -217,16 +352,73 @@ func (s *Server) DeleteCurrentUser(w http.ResponseWriter, r *http.Request) {
 		s.JSON(w, r, http.StatusInternalServerError, nil, "internal server error")
 		return
 	}
+
 	userID := claims.ID
-	if err := storage.Delete[models.User](ctx, "users", s.DB, func(sb *sqlbuilder.SelectBuilder) {
+
+	user, err := storage.GetOne[models.User](ctx, s.DB, "users", func(sb *sqlbuilder.SelectBuilder) {
 		sb.Where(sb.Equal("id", userID))
-	}); err != nil {
-		slog.ErrorContext(ctx, "Error deleting user by ID", slog.String("error", err.Error()), slog.Any("ID", userID))
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting user by ID", slog.Any("ID", userID), slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
-	s.JSON(w, r, http.StatusOK, true, "user")
+	export := map[string]any{
+		"exported_at": time.Now().UTC(),
+		"user":        user,
+	}
+	for _, part := range userExportParts {
+		rows, err := part.load(ctx, s.DB, userID)
+		if err != nil {
+			slog.ErrorContext(ctx, "Error exporting user data", slog.String("part", part.key), slog.String("error", err.Error()))
+			s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
+			return
+		}
+		export[part.key] = rows
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="handbooks-export-%s.json"`, user.Slug))
+	s.JSON(w, r, http.StatusOK, export, "export")
+}
+
+// exportPart — часть выгрузки: строки одной таблицы, принадлежащие пользователю
+type exportPart struct {
+	key  string
+	load func(ctx context.Context, db storage.Querier, userID uuid.UUID) (any, error)
+}
+
+func exportRows[T any](key, table, column string) exportPart {
+	return exportPart{key: key, load: func(ctx context.Context, db storage.Querier, userID uuid.UUID) (any, error) {
+		return storage.GetAll[T](ctx, table, db, func(sb *sqlbuilder.SelectBuilder) {
+			sb.Where(sb.Equal(column, userID))
+		})
+	}}
+}
+
+// recoveryCodeExport — код восстановления 2FA в выгрузке, без хэша
+type recoveryCodeExport struct {
+	ID        uuid.UUID  `db:"id"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// userExportParts — все таблицы, где есть id пользователя. Новую таблицу со ссылкой на users
+// нужно добавить и сюда, иначе её данные не попадут в выгрузку
+var userExportParts = []exportPart{
+	exportRows[models.Course]("courses", "courses", "created_id"),
+	exportRows[models.Section]("sections", "sections", "created_id"),
+	exportRows[models.Lesson]("lessons", "lessons", "created_id"),
+	exportRows[models.CourseInstructor]("course_instructors", "course_instructors", "user_id"),
+	exportRows[models.Identity]("identities", "user_identities", "user_id"),
+	exportRows[recoveryCodeExport]("recovery_codes", "user_recovery_codes", "user_id"),
+	exportRows[models.Enrollment]("enrollments", "enrollments", "user_id"),
+	exportRows[models.LessonProgress]("lesson_progress", "lesson_progress", "user_id"),
+	exportRows[models.LessonRevision]("lesson_revisions", "lesson_revisions", "author_id"),
+	exportRows[models.CourseStatusChange]("course_status_history", "course_status_history", "actor_id"),
+	exportRows[models.CourseVersion]("course_versions", "course_versions", "published_by"),
+	exportRows[models.VersionSection]("course_version_sections", "course_version_sections", "created_id"),
+	exportRows[models.VersionLesson]("course_version_lessons", "course_version_lessons", "created_id"),
 }
 
 // UsersGetCurrent implements [api.ServerInterface].
@@ -261,52 +453,89 @@ func (s *Server) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 }
 
 // UpdateCurrentUser implements [api.ServerInterface].
+// Пользователь меняет только имя, аватар и пароль; email, роль и остальные поля
+// через этот эндпоинт не обновляются. Новый пароль подтверждается текущим.
 func (s *Server) UpdateCurrentUser(w http.ResponseWriter, r *http.Request) {
 	var (
-		ctx  = r.Context()
-		user models.User
+		ctx = r.Context()
+		req api.UserUpdate
 	)
 
-	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		slog.ErrorContext(ctx, "Error decoding request body", slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusBadRequest, "Invalid request body", "error")
 		return
 	}
 
-	claimsValue := ctx.Value("user")
-
-	claims, ok := claimsValue.(*Claims)
+	user, ok := s.currentUser(w, r)
 	if !ok {
-		slog.ErrorContext(ctx, "Error parsing claims", slog.Any("Claims", claimsValue))
-		s.JSON(w, r, http.StatusInternalServerError, nil, "internal server error")
 		return
 	}
 
-	userID := claims.ID
-	if err := storage.Update[models.User](ctx, "users", user, s.DB, func(sb *sqlbuilder.UpdateBuilder) {
-		sb.Where(sb.Equal("id", userID))
+	fields := map[string]any{}
+
+	if req.FullName != nil {
+		name := strings.TrimSpace(*req.FullName)
+		if n := utf8.RuneCountInString(name); n < 2 || n > 100 {
+			s.JSON(w, r, http.StatusBadRequest, "fullName must be 2 to 100 characters", "error")
+			return
+		}
+		fields["full_name"] = name
+	}
+
+	if req.AvatarUrl != nil {
+		fields["avatar_url"] = *req.AvatarUrl
+	}
+
+	if req.Password != nil {
+		if len(*req.Password) < 8 {
+			s.JSON(w, r, http.StatusBadRequest, "Password must be at least 8 characters", "error")
+			return
+		}
+		// У аккаунта, созданного через внешнего провайдера, пароля ещё нет — его можно задать без текущего
+		if user.PasswordHash != "" {
+			if req.CurrentPassword == nil || bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(*req.CurrentPassword)) != nil {
+				s.JSON(w, r, http.StatusForbidden, "Неверный текущий пароль", "error")
+				return
+			}
+		}
+
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+			return
+		}
+		fields["password_hash"] = string(passwordHash)
+	}
+
+	if len(fields) == 0 {
+		s.JSON(w, r, http.StatusOK, user, "user")
+		return
+	}
+	fields["updated_at"] = time.Now()
+
+	if err := storage.UpdateFields(ctx, "users", fields, s.DB, func(ub *sqlbuilder.UpdateBuilder) {
+		ub.Where(ub.Equal("id", user.ID))
 	}); err != nil {
 		slog.ErrorContext(ctx, "Error updating user", slog.String("error", err.Error()))
 		s.JSON(w, r, http.StatusInternalServerError, "Internal server error", "error")
 		return
 	}
 
-	s.JSON(w, r, http.StatusCreated, user, "user")
-}
-
-// GetUserProgress implements [api.ServerInterface].
-func (s *Server) GetUserProgress(w http.ResponseWriter, r *http.Request) {
-	panic("unimplemented")
-}
+	user, ok = s.currentUser(w, r)
+	if !ok {
+		return
+	}
 
-// UpdateLessonProgress implements [api.ServerInterface].
-func (s *Server) UpdateLessonProgress(w http.ResponseWriter, r *http.Request, courseID string, lessonID string) {
-	panic("unimplemented")
+	s.JSON(w, r, http.StatusOK, user, "user")
 }
 
-// issueTokens — общая функция выдачи токенов (логин + регистрация)
+// issueTokens — общая функция выдачи токенов (логин, регистрация, обновление по refresh).
+// Время жизни токенов, куки и expires_in берутся из redis.accessTokenTTL / redis.refreshTokenTTL.
 func (s *Server) issueTokens(w http.ResponseWriter, r *http.Request, user *models.User) {
-	access, err := s.generateAccessToken(user, s.Config.RedisAccessTokenDur())
+	cfg := s.Config()
+
+	access, err := s.generateAccessToken(user, cfg.RedisAccessTokenDur())
 	if err != nil {
 		slog.ErrorContext(r.Context(), "generate access failed", "err", err)
 		s.JSON(w, r, http.StatusInternalServerError, "Token generation error", "error")
@@ -321,7 +550,7 @@ func (s *Server) issueTokens(w http.ResponseWriter, r *http.Request, user *model
 	}
 
 	key := fmt.Sprintf("refresh_hash:%v", refresh)
-	err = s.Redis.Set(r.Context(), key, "valid", s.Config.RedisAccessTokenDur()).Err()
+	err = s.Redis.Set(r.Context(), key, "valid", cfg.RedisRefreshTokenDur()).Err()
 	if err != nil {
 		slog.ErrorContext(r.Context(), "redis set refresh failed", "err", err)
 		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
@@ -329,12 +558,18 @@ func (s *Server) issueTokens(w http.ResponseWriter, r *http.Request, user *model
 	}
 
 	accessKey := fmt.Sprintf("access_hash:%v", access)
-	err = s.Redis.Set(r.Context(), accessKey, "valid", s.Config.RedisAccessTokenDur()).Err()
+	err = s.Redis.Set(r.Context(), accessKey, "valid", cfg.RedisAccessTokenDur()).Err()
 	if err != nil {
 		slog.ErrorContext(r.Context(), "redis set access failed", "err", err)
 		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
 		return
 	}
+
+	if err := s.trackUserTokens(r.Context(), user.ID, key, accessKey); err != nil {
+		slog.ErrorContext(r.Context(), "redis track tokens failed", "err", err)
+		s.JSON(w, r, http.StatusInternalServerError, "Server error", "error")
+		return
+	}
 	http.SetCookie(w, &http.Cookie{
 		Name:     "refresh_token",
 		Value:    refresh,
@@ -342,12 +577,12 @@ func (s *Server) issueTokens(w http.ResponseWriter, r *http.Request, user *model
 		Secure:   true,
 		SameSite: http.SameSiteStrictMode,
 		Path:     "/",
-		MaxAge:   7 * 24 * 3600,
+		MaxAge:   int(cfg.RedisRefreshTokenDur().Seconds()),
 	})
 
 	s.JSON(w, r, http.StatusOK, map[string]any{
 		"access_token": access,
-		"expires_in":   86400, // 24 часа
+		"expires_in":   int(cfg.RedisAccessTokenDur().Seconds()),
 	}, "auth")
 }
 
@@ -382,7 +617,7 @@ func (s *Server) generateJWT(user *models.User, lifetime time.Duration) (string,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(lifetime)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Subject:   user.ID.String(),
-			Issuer:    s.Config.JwtOpt.Issuer,
+			Issuer:    s.Config().JwtOpt.Issuer,
 		},
 	}
 
@@ -390,6 +625,36 @@ func (s *Server) generateJWT(user *models.User, lifetime time.Duration) (string,
 	return token.SignedString(s.JwtKey)
 }
 
+// trackUserTokens — запоминает ключи выданных токенов в множестве пользователя,
+// чтобы их можно было отозвать разом
+func (s *Server) trackUserTokens(ctx context.Context, userID uuid.UUID, keys ...string) error {
+	setKey := "user_tokens:" + userID.String()
+
+	members := make([]any, 0, len(keys))
+	for _, k := range keys {
+		members = append(members, k)
+	}
+
+	pipe := s.Redis.TxPipeline()
+	pipe.SAdd(ctx, setKey, members...)
+	pipe.Expire(ctx, setKey, s.Config().RedisRefreshTokenDur())
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// revokeUserTokens — удаляет из Redis все access и refresh токены пользователя
+func (s *Server) revokeUserTokens(ctx context.Context, userID uuid.UUID) error {
+	setKey := "user_tokens:" + userID.String()
+
+	keys, err := s.Redis.SMembers(ctx, setKey).Result()
+	if err != nil {
+		return err
+	}
+
+	return s.Redis.Del(ctx, append(keys, setKey)...).Err()
+}
+
 func (s *Server) deleteRefreshCookie(w http.ResponseWriter) {
 	http.SetCookie(w, &http.Cookie{
 		Name:   "refresh_token",
@@ -0,0 +1,406 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"handbooks/internal/api"
+	"handbooks/internal/apitest"
+	"handbooks/internal/config"
+	"handbooks/internal/models"
+
+	"github.com/pquerna/otp/totp"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestRegisterLoginMe(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	registered := h.Register("smoke@apitest.local", "smoke-password", "Smoke Test")
+	s := h.Login("smoke@apitest.local", "smoke-password")
+	if s.UserID != registered.UserID {
+		t.Fatalf("login returned another user: %s != %s", s.UserID, registered.UserID)
+	}
+
+	resp, err := s.Client.GetCurrentUserWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("GET /me: %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	me := apitest.Data[struct {
+		Email    string `json:"email"`
+		FullName string `json:"fullName"`
+		Role     string `json:"role"`
+	}](t, resp.Body, "user")
+	if me.Email != "smoke@apitest.local" || me.FullName != "Smoke Test" || me.Role != models.RoleStudent {
+		t.Fatalf("unexpected /me: %s", resp.Body)
+	}
+
+	anonymous, err := h.Anonymous().Client.GetCurrentUserWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if anonymous.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("GET /me without token: expected 401, got %d", anonymous.StatusCode())
+	}
+}
+
+func TestUpdateCurrentUser(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	s := h.NewUser(models.RoleStudent)
+	before := s.User()
+
+	t.Run("protected fields are ignored", func(t *testing.T) {
+		resp, err := s.Client.UpdateCurrentUserWithBodyWithResponse(ctx, "application/json",
+			strings.NewReader(`{"fullName":"Renamed User","role":"admin","email":"hijack@apitest.local","id":"00000000-0000-0000-0000-000000000000"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			t.Fatalf("PATCH /me: %d: %s", resp.StatusCode(), resp.Body)
+		}
+
+		after := s.User()
+		if after.FullName != "Renamed User" {
+			t.Fatalf("full name not updated: %q", after.FullName)
+		}
+		if after.ID != before.ID || after.Role != before.Role || after.Email != before.Email || after.PasswordHash != before.PasswordHash {
+			t.Fatalf("protected fields changed: %+v -> %+v", before, after)
+		}
+	})
+
+	t.Run("password change requires current password", func(t *testing.T) {
+		wrong, next := "wrong-password", "changed-password"
+		resp, err := s.Client.UpdateCurrentUserWithResponse(ctx, api.UpdateCurrentUserJSONRequestBody{
+			CurrentPassword: &wrong,
+			Password:        &next,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusForbidden {
+			t.Fatalf("expected 403, got %d: %s", resp.StatusCode(), resp.Body)
+		}
+
+		current := apitest.DefaultPassword
+		resp, err = s.Client.UpdateCurrentUserWithResponse(ctx, api.UpdateCurrentUserJSONRequestBody{
+			CurrentPassword: &current,
+			Password:        &next,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			t.Fatalf("PATCH /me password: %d: %s", resp.StatusCode(), resp.Body)
+		}
+
+		if err := bcrypt.CompareHashAndPassword([]byte(s.User().PasswordHash), []byte(next)); err != nil {
+			t.Fatalf("new password not stored: %v", err)
+		}
+		h.Login(s.Email, next)
+	})
+}
+
+func TestLoginLockout(t *testing.T) {
+	h := apitest.New(t, func(cfg *config.Config) {
+		cfg.Auth.MaxLoginAttempts = 3
+	})
+	ctx := context.Background()
+
+	s := h.NewUser(models.RoleStudent)
+	client := h.Anonymous().Client
+
+	login := func(password string) *api.AuthLoginUserResponse {
+		t.Helper()
+		resp, err := client.AuthLoginUserWithResponse(ctx, api.AuthLoginUserJSONRequestBody{Email: s.Email, Password: password})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	for i := range 2 {
+		if resp := login("wrong-password"); resp.StatusCode() != http.StatusUnauthorized {
+			t.Fatalf("attempt %d: expected 401, got %d", i+1, resp.StatusCode())
+		}
+	}
+
+	// Третья неудача достигает лимита и блокирует вход
+	if resp := login("wrong-password"); resp.StatusCode() != http.StatusTooManyRequests {
+		t.Fatalf("attempt 3: expected 429, got %d", resp.StatusCode())
+	}
+
+	locked := login(apitest.DefaultPassword)
+	if locked.StatusCode() != http.StatusTooManyRequests {
+		t.Fatalf("correct password while locked: expected 429, got %d", locked.StatusCode())
+	}
+	retryAfter, err := strconv.Atoi(locked.HTTPResponse.Header.Get("Retry-After"))
+	if err != nil || retryAfter <= 0 {
+		t.Fatalf("Retry-After = %q", locked.HTTPResponse.Header.Get("Retry-After"))
+	}
+
+	h.Miniredis.FastForward(h.Config.Auth.LockoutBaseDur + time.Second)
+	if resp := login(apitest.DefaultPassword); resp.StatusCode() != http.StatusOK {
+		t.Fatalf("after lockout: expected 200, got %d: %s", resp.StatusCode(), resp.Body)
+	}
+}
+
+func TestPasswordLoginWithSecondFactor(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	s := h.NewUser(models.RoleStudent)
+	secret, recoveryCodes := s.EnableTotp()
+	if len(recoveryCodes) == 0 {
+		t.Fatal("no recovery codes issued")
+	}
+
+	challenge := func() string {
+		t.Helper()
+		resp, err := h.Anonymous().Client.AuthLoginUserWithResponse(ctx, api.AuthLoginUserJSONRequestBody{
+			Email:    s.Email,
+			Password: apitest.DefaultPassword,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		auth := apitest.Data[struct {
+			MfaRequired    bool   `json:"mfa_required"`
+			ChallengeToken string `json:"challenge_token"`
+			AccessToken    string `json:"access_token"`
+		}](t, resp.Body, "auth")
+		if !auth.MfaRequired || auth.ChallengeToken == "" || auth.AccessToken != "" {
+			t.Fatalf("expected 2FA challenge instead of tokens: %s", resp.Body)
+		}
+		return auth.ChallengeToken
+	}
+
+	verify := func(token, code string) *api.AuthVerifyMfaResponse {
+		t.Helper()
+		resp, err := h.Anonymous().Client.AuthVerifyMfaWithResponse(ctx, api.AuthVerifyMfaJSONRequestBody{ChallengeToken: token, Code: code})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	t.Run("totp code", func(t *testing.T) {
+		token := challenge()
+
+		if resp := verify(token, "000000"); resp.StatusCode() != http.StatusUnauthorized {
+			t.Fatalf("wrong code: expected 401, got %d", resp.StatusCode())
+		}
+
+		code, err := totp.GenerateCode(secret, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := verify(token, code)
+		if got := h.SessionFromAuth(resp.StatusCode(), resp.Body); got.UserID != s.UserID {
+			t.Fatalf("logged in as %s, want %s", got.UserID, s.UserID)
+		}
+
+		// Тот же код повторно не принимается
+		if resp := verify(challenge(), code); resp.StatusCode() != http.StatusUnauthorized {
+			t.Fatalf("reused code: expected 401, got %d", resp.StatusCode())
+		}
+	})
+
+	t.Run("recovery code is single use", func(t *testing.T) {
+		resp := verify(challenge(), recoveryCodes[0])
+		h.SessionFromAuth(resp.StatusCode(), resp.Body)
+
+		if resp := verify(challenge(), recoveryCodes[0]); resp.StatusCode() != http.StatusUnauthorized {
+			t.Fatalf("reused recovery code: expected 401, got %d", resp.StatusCode())
+		}
+	})
+
+	t.Run("challenge expires", func(t *testing.T) {
+		token := challenge()
+		h.Miniredis.FastForward(h.Config.Auth.MfaChallengeDur + time.Second)
+
+		if resp := verify(token, recoveryCodes[1]); resp.StatusCode() != http.StatusUnauthorized {
+			t.Fatalf("expired challenge: expected 401, got %d", resp.StatusCode())
+		}
+	})
+}
+
+func TestSecondFactorLockout(t *testing.T) {
+	h := apitest.New(t, func(cfg *config.Config) {
+		cfg.Auth.MaxLoginAttempts = 3
+	})
+	ctx := context.Background()
+
+	s := h.NewUser(models.RoleStudent)
+	secret, _ := s.EnableTotp()
+
+	challenge := func() string {
+		t.Helper()
+		resp, err := h.Anonymous().Client.AuthLoginUserWithResponse(ctx, api.AuthLoginUserJSONRequestBody{Email: s.Email, Password: apitest.DefaultPassword})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(t, "password step", resp.StatusCode(), http.StatusOK)
+		return apitest.Data[struct {
+			ChallengeToken string `json:"challenge_token"`
+		}](t, resp.Body, "auth").ChallengeToken
+	}
+
+	verify := func(token, code string) *api.AuthVerifyMfaResponse {
+		t.Helper()
+		resp, err := h.Anonymous().Client.AuthVerifyMfaWithResponse(ctx, api.AuthVerifyMfaJSONRequestBody{ChallengeToken: token, Code: code})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	// Каждая неудача берёт новый challenge: лимит на один challenge тут не срабатывает,
+	// а верный пароль не сбрасывает счётчик второго фактора
+	for i := range 2 {
+		if resp := verify(challenge(), "000000"); resp.StatusCode() != http.StatusUnauthorized {
+			t.Fatalf("attempt %d: expected 401, got %d", i+1, resp.StatusCode())
+		}
+	}
+	if resp := verify(challenge(), "000000"); resp.StatusCode() != http.StatusTooManyRequests {
+		t.Fatalf("attempt 3: expected 429, got %d", resp.StatusCode())
+	}
+
+	code, err := totp.GenerateCode(secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	locked := verify(challenge(), code)
+	if locked.StatusCode() != http.StatusTooManyRequests {
+		t.Fatalf("valid code while locked: expected 429, got %d", locked.StatusCode())
+	}
+	if locked.HTTPResponse.Header.Get("Retry-After") == "" {
+		t.Fatal("no Retry-After while locked")
+	}
+
+	h.Miniredis.FastForward(h.Config.Auth.LockoutBaseDur + time.Second)
+	resp := verify(challenge(), code)
+	if got := h.SessionFromAuth(resp.StatusCode(), resp.Body); got.UserID != s.UserID {
+		t.Fatalf("logged in as %s, want %s", got.UserID, s.UserID)
+	}
+}
+
+func TestDeleteCurrentUser(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	s := h.NewUser(models.RoleStudent)
+
+	wrong := "wrong-password"
+	resp, err := s.Client.DeleteCurrentUserWithResponse(ctx, api.DeleteCurrentUserJSONRequestBody{Password: &wrong})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusBadRequest {
+		t.Fatalf("wrong password: expected 400, got %d", resp.StatusCode())
+	}
+
+	password := apitest.DefaultPassword
+	resp, err = s.Client.DeleteCurrentUserWithResponse(ctx, api.DeleteCurrentUserJSONRequestBody{Password: &password})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("delete: %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	login, err := h.Anonymous().Client.AuthLoginUserWithResponse(ctx, api.AuthLoginUserJSONRequestBody{Email: s.Email, Password: password})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("login after delete: expected 401, got %d", login.StatusCode())
+	}
+}
+
+func TestDeleteInstructorKeepsContent(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	owner := h.NewUser(models.RoleInstructor)
+	admin := h.NewUser(models.RoleAdmin)
+
+	course := createCourse(t, owner, true)
+	editLesson(t, owner, course, "# Первый урок\n\nПравка автора")
+	publishCourse(t, owner, admin, course.ID)
+
+	resp, err := owner.Client.DeleteCurrentUserWithResponse(ctx, api.DeleteCurrentUserJSONRequestBody{Password: ptr(apitest.DefaultPassword)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("delete instructor: %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	// Курс, раздел, урок, ревизии и опубликованная версия остаются, теряя только автора
+	for name, query := range map[string]string{
+		"course":    "SELECT COUNT(*) FILTER (WHERE created_id IS NULL), COUNT(*) FROM courses WHERE id = $1",
+		"section":   "SELECT COUNT(*) FILTER (WHERE created_id IS NULL), COUNT(*) FROM sections WHERE course_id = $1",
+		"lesson":    "SELECT COUNT(*) FILTER (WHERE created_id IS NULL), COUNT(*) FROM lessons WHERE course_id = $1",
+		"revisions": "SELECT COUNT(*) FILTER (WHERE r.author_id IS NULL), COUNT(*) FROM lesson_revisions r JOIN lessons l ON l.id = r.lesson_id WHERE l.course_id = $1",
+		"version":   "SELECT COUNT(*) FILTER (WHERE published_by IS NULL), COUNT(*) FROM course_versions WHERE course_id = $1",
+	} {
+		var detached, total int
+		if err := h.DB.QueryRow(ctx, query, course.ID).Scan(&detached, &total); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if total == 0 || detached != total {
+			t.Fatalf("%s: %d of %d rows detached from the deleted author", name, detached, total)
+		}
+	}
+
+	got, err := admin.Client.GetCourseByIDWithResponse(ctx, course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "course after author delete", got.StatusCode(), http.StatusOK)
+}
+
+func TestDeleteUserByAdmin(t *testing.T) {
+	h := apitest.New(t)
+	ctx := context.Background()
+
+	admin := h.NewUser(models.RoleAdmin)
+	instructor := h.NewUser(models.RoleInstructor)
+	target := h.NewUser(models.RoleStudent)
+
+	denied, err := instructor.Client.DeleteUserByIdWithResponse(ctx, target.UserID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "delete by instructor", denied.StatusCode(), http.StatusForbidden)
+
+	missing, err := admin.Client.DeleteUserByIdWithResponse(ctx, "00000000-0000-0000-0000-000000000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "delete unknown user", missing.StatusCode(), http.StatusNotFound)
+
+	resp, err := admin.Client.DeleteUserByIdWithResponse(ctx, target.UserID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "delete by admin", resp.StatusCode(), http.StatusOK)
+
+	// Выданные удалённому пользователю токены больше не действуют
+	me, err := target.Client.GetCurrentUserWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, "token of deleted user", me.StatusCode(), http.StatusUnauthorized)
+}
@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/golang-cz/devslog"
+)
+
+// Форматы вывода логов
+const (
+	FormatDev  = "dev"  // цветной многострочный вывод devslog для локальной разработки
+	FormatJSON = "json" // одна JSON-строка на запись, для сборщиков логов в проде
+	FormatText = "text" // logfmt (key=value)
+)
+
+// level — общий уровень логирования, его можно менять без пересоздания логгера
+var level = new(slog.LevelVar)
+
+// Options — настройки логгера
+type Options struct {
+	Format     string
+	Level      slog.Level
+	RedactKeys []string
+}
+
+// Setup — создаёт логгер по настройкам и делает его логгером по умолчанию.
+// Цепочка обработчиков: контекст запроса (request_id, user_id, trace_id) → маскировка → вывод.
+func Setup(opts Options) error {
+	base, err := newHandler(os.Stdout, opts.Format)
+	if err != nil {
+		return err
+	}
+
+	level.Set(opts.Level)
+
+	handler := NewContextHandler(NewRedactHandler(base, opts.RedactKeys))
+	slog.SetDefault(slog.New(handler))
+
+	return nil
+}
+
+// SetLevel — меняет уровень логирования на лету
+func SetLevel(l slog.Level) {
+	level.Set(l)
+}
+
+func newHandler(w io.Writer, format string) (slog.Handler, error) {
+	switch format {
+	case FormatDev, "":
+		return devslog.NewHandler(w, &devslog.Options{
+			HandlerOptions:    &slog.HandlerOptions{Level: level},
+			MaxSlicePrintSize: 4,
+			SortKeys:          true,
+			TimeFormat:        "15:04:05.000",
+			NewLineAfterLog:   true,
+			DebugColor:        devslog.Magenta,
+			StringerFormatter: true,
+		}), nil
+	case FormatJSON:
+		return slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level}), nil
+	case FormatText:
+		return slog.NewTextHandler(w, &slog.HandlerOptions{Level: level}), nil
+	default:
+		return nil, fmt.Errorf("неизвестный формат логов %q", format)
+	}
+}
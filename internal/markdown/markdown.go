@@ -0,0 +1,154 @@
+// Package markdown превращает Markdown текстовых уроков в безопасный HTML:
+// GFM (таблицы, списки задач, зачёркивание), блоки кода и формулы $...$ и $$...$$,
+// которые фронтенд отрисовывает KaTeX. Заодно строит оглавление и оценивает время чтения.
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gosimple/slug"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// wordsPerMinute — скорость чтения учебного текста, по ней считается ReadingTimeSec
+const wordsPerMinute = 180
+
+// Heading — заголовок в оглавлении урока; ID совпадает с атрибутом id заголовка в HTML
+type Heading struct {
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+	ID    string `json:"id"`
+}
+
+// Document — отрисованный урок
+type Document struct {
+	HTML           string
+	TOC            []Heading
+	Words          int
+	ReadingTimeSec int
+}
+
+var (
+	md = goldmark.New(
+		goldmark.WithExtensions(extension.GFM, mathExtension{}),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+
+	// policy — разрешённый HTML: всё, что допускает UGC-политика, плюс классы подсветки кода,
+	// формулы и чекбоксы списков задач. Сырой HTML из Markdown goldmark не пропускает и сам,
+	// политика — вторая линия защиты
+	policy = func() *bluemonday.Policy {
+		p := bluemonday.UGCPolicy()
+		p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#.-]+$`)).OnElements("code")
+		p.AllowAttrs("class").Matching(regexp.MustCompile(`^math math-(inline|display)$`)).OnElements("span", "div")
+		p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+		p.AllowAttrs("checked", "disabled").OnElements("input")
+		return p
+	}()
+
+	plainText = bluemonday.StrictPolicy()
+)
+
+// Render — HTML, оглавление и время чтения для Markdown-текста
+func Render(source string) (*Document, error) {
+	src := []byte(source)
+	pc := parser.NewContext(parser.WithIDs(newHeadingIDs()))
+	doc := md.Parser().Parse(text.NewReader(src), parser.WithContext(pc))
+
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, src, doc); err != nil {
+		return nil, err
+	}
+	html := policy.Sanitize(buf.String())
+	words := len(strings.Fields(plainText.Sanitize(html)))
+
+	return &Document{
+		HTML:           html,
+		TOC:            tableOfContents(doc, src),
+		Words:          words,
+		ReadingTimeSec: ReadingTimeSec(words),
+	}, nil
+}
+
+// ReadingTimeSec — время чтения words слов, округлённое вверх до минуты
+func ReadingTimeSec(words int) int {
+	if words <= 0 {
+		return 0
+	}
+	return (words + wordsPerMinute - 1) / wordsPerMinute * 60
+}
+
+func tableOfContents(doc ast.Node, source []byte) []Heading {
+	var toc []Heading
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+		id, _ := heading.AttributeString("id")
+		idBytes, _ := id.([]byte)
+		toc = append(toc, Heading{
+			Level: heading.Level,
+			Text:  strings.TrimSpace(nodeText(heading, source)),
+			ID:    string(idBytes),
+		})
+		return ast.WalkSkipChildren, nil
+	})
+	return toc
+}
+
+// nodeText — текст узла без разметки
+func nodeText(n ast.Node, source []byte) string {
+	var sb strings.Builder
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		switch c := c.(type) {
+		case *ast.Text:
+			sb.Write(c.Segment.Value(source))
+			if c.SoftLineBreak() {
+				sb.WriteByte(' ')
+			}
+		case *ast.String:
+			sb.Write(c.Value)
+		case *mathInline:
+			sb.Write(c.Value)
+		default:
+			sb.WriteString(nodeText(c, source))
+		}
+	}
+	return sb.String()
+}
+
+// headingIDs — id заголовков из транслитерации текста (slug), уникальные в пределах урока.
+// Стандартный генератор goldmark выбрасывает кириллицу и даёт одинаковые "heading-N"
+type headingIDs struct {
+	used map[string]bool
+}
+
+func newHeadingIDs() *headingIDs {
+	return &headingIDs{used: make(map[string]bool)}
+}
+
+func (h *headingIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	base := slug.Make(string(value))
+	if base == "" {
+		base = "section"
+	}
+	id := base
+	for i := 2; h.used[id]; i++ {
+		id = base + "-" + strconv.Itoa(i)
+	}
+	h.used[id] = true
+	return []byte(id)
+}
+
+func (h *headingIDs) Put(value []byte) {
+	h.used[string(value)] = true
+}
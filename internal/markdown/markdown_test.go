@@ -0,0 +1,281 @@
+package markdown_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"handbooks/internal/markdown"
+)
+
+func render(t *testing.T, source string) *markdown.Document {
+	t.Helper()
+	doc, err := markdown.Render(source)
+	if err != nil {
+		t.Fatalf("Render(%q): %v", source, err)
+	}
+	return doc
+}
+
+func TestRenderSanitizes(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		forbidden []string
+		want      string
+	}{
+		{
+			name:      "script tag",
+			source:    "Текст\n\n<script>alert(1)</script>",
+			forbidden: []string{"<script", "alert(1)</script>"},
+			want:      "<p>Текст</p>",
+		},
+		{
+			name:      "inline script",
+			source:    "до <script>alert(1)</script> после",
+			forbidden: []string{"<script"},
+			want:      "после",
+		},
+		{
+			name:      "event handler attribute",
+			source:    `<img src="x.png" onerror="alert(1)">`,
+			forbidden: []string{"onerror", "alert(1)"},
+		},
+		{
+			name:      "javascript link",
+			source:    "[нажми](javascript:alert(1))",
+			forbidden: []string{"javascript:"},
+			want:      "нажми",
+		},
+		{
+			name:      "javascript image",
+			source:    "![x](javascript:alert(1))",
+			forbidden: []string{"javascript:"},
+		},
+		{
+			name:      "iframe",
+			source:    `<iframe src="https://evil.example"></iframe>`,
+			forbidden: []string{"<iframe", "evil.example"},
+		},
+		{
+			name:      "style attribute",
+			source:    `<p style="position:fixed">x</p>`,
+			forbidden: []string{"style="},
+		},
+		{
+			name:   "safe link stays",
+			source: "[docs](https://example.com/docs)",
+			want:   `<a href="https://example.com/docs" rel="nofollow">docs</a>`,
+		},
+		{
+			name:   "code language class stays",
+			source: "```go\nfmt.Println(1)\n```",
+			want:   `<code class="language-go">`,
+		},
+		{
+			name:   "task list checkbox stays",
+			source: "- [x] готово",
+			want:   `type="checkbox"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := render(t, tt.source).HTML
+			for _, f := range tt.forbidden {
+				if strings.Contains(strings.ToLower(html), strings.ToLower(f)) {
+					t.Errorf("HTML contains %q: %s", f, html)
+				}
+			}
+			if tt.want != "" && !strings.Contains(html, tt.want) {
+				t.Errorf("HTML does not contain %q: %s", tt.want, html)
+			}
+		})
+	}
+}
+
+func TestRenderMath(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+		absent string
+	}{
+		{
+			name:   "inline",
+			source: "Пусть $a_1 * b_2$ и *c*",
+			want:   `<span class="math math-inline">a_1 * b_2</span>`,
+		},
+		{
+			name:   "inline markdown is not parsed",
+			source: "$*a* + _b_$",
+			want:   `<span class="math math-inline">*a* + _b_</span>`,
+			absent: "<em>",
+		},
+		{
+			name:   "inline html is escaped",
+			source: "$a <b> c$",
+			want:   `<span class="math math-inline">a &lt;b&gt; c</span>`,
+			absent: "<b>",
+		},
+		{
+			name:   "inline script is escaped",
+			source: "$</span><script>alert(1)</script>$",
+			absent: "<script",
+		},
+		{
+			name:   "display inline",
+			source: "Итог $$\\sum_i x_i$$ ниже",
+			want:   `<span class="math math-display">\sum_i x_i</span>`,
+		},
+		{
+			name:   "block",
+			source: "$$\nx < y\n\\\\ z\n$$",
+			want:   "<div class=\"math math-display\">x &lt; y\n\\\\ z\n</div>",
+		},
+		{
+			name:   "block on one line",
+			source: "$$ e = mc^2 $$",
+			want:   `<div class="math math-display"> e = mc^2 </div>`,
+		},
+		{
+			name:   "prices are text",
+			source: "Стоит $5 или $10",
+			want:   "<p>Стоит $5 или $10</p>",
+			absent: "math",
+		},
+		{
+			name:   "space inside delimiters is text",
+			source: "$ a $",
+			absent: "math",
+		},
+		{
+			name:   "code span keeps dollars",
+			source: "`$x$`",
+			want:   "<code>$x$</code>",
+			absent: "math",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := render(t, tt.source).HTML
+			if tt.want != "" && !strings.Contains(html, tt.want) {
+				t.Errorf("HTML does not contain %q: %s", tt.want, html)
+			}
+			if tt.absent != "" && strings.Contains(html, tt.absent) {
+				t.Errorf("HTML contains %q: %s", tt.absent, html)
+			}
+		})
+	}
+}
+
+func TestRenderTableOfContents(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []markdown.Heading
+	}{
+		{
+			name:   "no headings",
+			source: "Просто текст",
+		},
+		{
+			name:   "cyrillic is transliterated",
+			source: "# Введение\n\n## Что дальше?",
+			want: []markdown.Heading{
+				{Level: 1, Text: "Введение", ID: "vvedenie"},
+				{Level: 2, Text: "Что дальше?", ID: "chto-dal-she"},
+			},
+		},
+		{
+			name:   "duplicates get suffixes",
+			source: "## Итоги\n\n## Итоги\n\n## Итоги",
+			want: []markdown.Heading{
+				{Level: 2, Text: "Итоги", ID: "itogi"},
+				{Level: 2, Text: "Итоги", ID: "itogi-2"},
+				{Level: 2, Text: "Итоги", ID: "itogi-3"},
+			},
+		},
+		{
+			name:   "markup is stripped from text",
+			source: "### Формула *Эйлера* и `code`",
+			want: []markdown.Heading{
+				{Level: 3, Text: "Формула Эйлера и code", ID: "formula-eilera-i-code"},
+			},
+		},
+		{
+			name:   "math in heading",
+			source: "## Площадь $S = a^2$",
+			want: []markdown.Heading{
+				{Level: 2, Text: "Площадь S = a^2", ID: "ploshchad-s-a-2"},
+			},
+		},
+		{
+			name:   "symbols only",
+			source: "## !!!",
+			want: []markdown.Heading{
+				{Level: 2, Text: "!!!", ID: "section"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := render(t, tt.source)
+			if !reflect.DeepEqual(doc.TOC, tt.want) {
+				t.Fatalf("TOC = %+v, want %+v", doc.TOC, tt.want)
+			}
+			for _, h := range tt.want {
+				if !strings.Contains(doc.HTML, `id="`+h.ID+`"`) {
+					t.Errorf("heading id %q not in HTML: %s", h.ID, doc.HTML)
+				}
+			}
+		})
+	}
+}
+
+func TestReadingTime(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		wantWords int
+		wantSec   int
+	}{
+		{name: "empty", source: "", wantWords: 0, wantSec: 0},
+		{name: "one word is a minute", source: "Привет", wantWords: 1, wantSec: 60},
+		{name: "markup is not counted", source: "# Заголовок\n\n**жирный** [ссылка](https://example.com)", wantWords: 3, wantSec: 60},
+		{name: "one minute exactly", source: strings.Repeat("слово ", 180), wantWords: 180, wantSec: 60},
+		{name: "rounded up", source: strings.Repeat("слово ", 181), wantWords: 181, wantSec: 120},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := render(t, tt.source)
+			if doc.Words != tt.wantWords || doc.ReadingTimeSec != tt.wantSec {
+				t.Fatalf("words = %d, seconds = %d; want %d, %d", doc.Words, doc.ReadingTimeSec, tt.wantWords, tt.wantSec)
+			}
+		})
+	}
+}
+
+func TestReadingTimeSec(t *testing.T) {
+	tests := []struct {
+		words int
+		want  int
+	}{
+		{-1, 0},
+		{0, 0},
+		{1, 60},
+		{180, 60},
+		{181, 120},
+		{360, 120},
+		{1000, 360},
+	}
+
+	for _, tt := range tests {
+		if got := markdown.ReadingTimeSec(tt.words); got != tt.want {
+			t.Errorf("ReadingTimeSec(%d) = %d, want %d", tt.words, got, tt.want)
+		}
+	}
+}
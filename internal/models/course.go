@@ -7,17 +7,61 @@ import (
 )
 
 type Course struct {
-	ID          string    `db:"id" fieldtag:"immutable"`
-	Slug        string    `db:"slug"`
-	Title       string    `db:"title"`
-	Subtitle    string    `db:"subtitle"`
-	Description string    `db:"description"`
-	CoverURL    string    `db:"cover_url"`
-	Status      string    `db:"status"`
-	Price       float64   `db:"price"`
-	Currency    string    `db:"currency"`
-	Level       string    `db:"level"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
-	CreatedID   uuid.UUID `db:"created_id" fieldtag:"immutable"`
+	ID                 string     `db:"id" fieldtag:"immutable"`
+	Slug               string     `db:"slug"`
+	Title              string     `db:"title"`
+	Subtitle           string     `db:"subtitle"`
+	Description        string     `db:"description"`
+	CoverURL           string     `db:"cover_url"`
+	Status             string     `db:"status" fieldtag:"immutable"` // меняется только переходами workflow публикации
+	Price              float64    `db:"price"`
+	Currency           string     `db:"currency"`
+	Level              string     `db:"level"`
+	PublishAt          *time.Time `db:"publish_at"` // публикация по расписанию, см. планировщик
+	CreatedAt          time.Time  `db:"created_at"`
+	UpdatedAt          time.Time  `db:"updated_at"`
+	CreatedID          uuid.UUID  `db:"created_id" fieldtag:"immutable"`
+	SourceCourseID     *uuid.UUID `db:"source_course_id" fieldtag:"immutable"`     // курс, копией которого создан этот
+	PublishedVersionID *uuid.UUID `db:"published_version_id" fieldtag:"immutable"` // последняя опубликованная версия; ученикам отдаётся, только пока курс опубликован
+}
+
+// Статусы курса
+const (
+	CourseStatusDraft     = "draft"
+	CourseStatusInReview  = "in_review"
+	CourseStatusApproved  = "approved"
+	CourseStatusPublished = "published"
+	CourseStatusArchived  = "archived"
+)
+
+// CourseInstructor — преподаватель курса
+type CourseInstructor struct {
+	ID          uuid.UUID `db:"id"`
+	CourseID    uuid.UUID `db:"course_id"`
+	UserID      uuid.UUID `db:"user_id"`
+	IsMain      bool      `db:"is_main"`
+	Position    int       `db:"position"`
+	BioOnCourse string    `db:"bio_on_course"`
+}
+
+// CourseStatusChange — запись истории переходов статуса курса
+type CourseStatusChange struct {
+	ID         uuid.UUID  `db:"id"`
+	CourseID   uuid.UUID  `db:"course_id"`
+	ActorID    *uuid.UUID `db:"actor_id"`
+	Action     string     `db:"action"`
+	FromStatus string     `db:"from_status"`
+	ToStatus   string     `db:"to_status"`
+	Comment    string     `db:"comment"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+// SlugRedirect — прежний slug курса, раздела или урока, по которому отдаётся редирект на текущий
+type SlugRedirect struct {
+	ID        uuid.UUID `db:"id"`
+	Entity    string    `db:"entity"`
+	ScopeID   uuid.UUID `db:"scope_id"`
+	Slug      string    `db:"slug"`
+	TargetID  uuid.UUID `db:"target_id"`
+	CreatedAt time.Time `db:"created_at"`
 }
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CourseVersion — опубликованная версия курса: неизменяемый снимок описания курса.
+// Разделы и уроки версии хранятся в VersionSection и VersionLesson
+type CourseVersion struct {
+	ID          uuid.UUID  `db:"id"`
+	CourseID    uuid.UUID  `db:"course_id"`
+	Number      int        `db:"number"`
+	Title       string     `db:"title"`
+	Subtitle    string     `db:"subtitle"`
+	Description string     `db:"description"`
+	CoverURL    string     `db:"cover_url"`
+	Price       float64    `db:"price"`
+	Currency    string     `db:"currency"`
+	Level       string     `db:"level"`
+	PublishedBy *uuid.UUID `db:"published_by"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
+
+// VersionSection — раздел в составе версии курса, с тем же id, что в рабочей копии
+type VersionSection struct {
+	VersionID uuid.UUID `db:"version_id"`
+	Section
+}
+
+// VersionLesson — урок в составе версии курса, с тем же id, что в рабочей копии
+type VersionLesson struct {
+	VersionID uuid.UUID `db:"version_id"`
+	Lesson
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Enrollment struct {
+	ID          uuid.UUID  `db:"id"`
+	UserID      uuid.UUID  `db:"user_id"`
+	CourseID    uuid.UUID  `db:"course_id"`
+	Status      string     `db:"status"`
+	EnrolledAt  time.Time  `db:"enrolled_at"`
+	CompletedAt *time.Time `db:"completed_at"`
+}
+
+// Статусы записи на курс
+const (
+	EnrollmentStatusActive    = "active"
+	EnrollmentStatusCompleted = "completed"
+)
+
+type LessonProgress struct {
+	ID             uuid.UUID  `db:"id"`
+	UserID         uuid.UUID  `db:"user_id"`
+	CourseID       uuid.UUID  `db:"course_id"`
+	LessonID       uuid.UUID  `db:"lesson_id"`
+	Percent        float64    `db:"percent"`
+	LastWatchedSec int        `db:"last_watched_sec"`
+	CompletedAt    *time.Time `db:"completed_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
@@ -7,17 +7,34 @@ import (
 )
 
 type Lesson struct {
-	ID          uuid.UUID `db:"id" fieldtag:"immutable"`
-	SectionID   uuid.UUID `db:"section_id"`
-	CourseID    uuid.UUID `db:"course_id"`
-	CreatedID   uuid.UUID `db:"created_id"`
-	Title       string    `db:"title"`
-	Slug        string    `db:"slug"`
-	Type        string    `db:"type"`
-	Content     string    `db:"content"`
-	Order       int       `db:"order"`
-	DurationSec int       `db:"duration_sec"`
-	IsPublished bool      `db:"is_published"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID                uuid.UUID  `db:"id" fieldtag:"immutable"`
+	SectionID         uuid.UUID  `db:"section_id" fieldtag:"immutable"` // переносится только через PUT /sections/{id}/lessons/order
+	CourseID          uuid.UUID  `db:"course_id" fieldtag:"immutable"`
+	CreatedID         uuid.UUID  `db:"created_id" fieldtag:"immutable"`
+	Title             string     `db:"title"`
+	Slug              string     `db:"slug"`
+	Type              string     `db:"type"`
+	Content           string     `db:"content"`
+	Order             int        `db:"order" fieldtag:"immutable" fieldopt:"withquote"` // меняется только через PUT /sections/{id}/lessons/order
+	DurationSec       int        `db:"duration_sec"`
+	IsPublished       bool       `db:"is_published"`
+	PublishAt         *time.Time `db:"publish_at"`          // публикация по расписанию, см. планировщик
+	DripDays          *int       `db:"drip_days"`           // drip: открыть через N дней после записи на курс
+	DripAfterPrevious bool       `db:"drip_after_previous"` // drip: открыть после прохождения предыдущего раздела
+	CreatedAt         time.Time  `db:"created_at"`
+	UpdatedAt         time.Time  `db:"updated_at"`
+}
+
+// LessonTypeText — текстовый урок: Content хранит Markdown
+const LessonTypeText = "text"
+
+// LessonRevision — неизменяемый снимок содержимого урока. Номера идут подряд с 1 в пределах урока
+type LessonRevision struct {
+	ID           uuid.UUID  `db:"id"`
+	LessonID     uuid.UUID  `db:"lesson_id"`
+	Number       int        `db:"number"`
+	AuthorID     *uuid.UUID `db:"author_id"`
+	Content      string     `db:"content"`
+	RestoredFrom *int       `db:"restored_from"` // номер ревизии, из которой восстановлено содержимое
+	CreatedAt    time.Time  `db:"created_at"`
 }
@@ -7,14 +7,16 @@ import (
 )
 
 type Section struct {
-	ID            uuid.UUID `db:"id"`
-	CourseID      uuid.UUID `db:"course_id"`
-	CreatedID     uuid.UUID `db:"created_id" fieldtag:"immutable"`
-	Title         string    `db:"title"`
-	Slug          string    `db:"slug"`
-	Order         int       `db:"order"`
-	IsFreePreview bool      `db:"is_free_preview"`
-	EstimatedTime int       `db:"estimated_time"`
-	CreatedAt     time.Time `db:"created_at"`
-	UpdatedAt     time.Time `db:"updated_at"`
+	ID                uuid.UUID `db:"id" fieldtag:"immutable"`
+	CourseID          uuid.UUID `db:"course_id" fieldtag:"immutable"`
+	CreatedID         uuid.UUID `db:"created_id" fieldtag:"immutable"`
+	Title             string    `db:"title"`
+	Slug              string    `db:"slug"`
+	Order             int       `db:"order" fieldtag:"immutable" fieldopt:"withquote"` // меняется только через PUT /courses/{id}/sections/order
+	IsFreePreview     bool      `db:"is_free_preview"`
+	EstimatedTime     int       `db:"estimated_time"`
+	DripDays          *int      `db:"drip_days"`           // drip: открыть через N дней после записи на курс
+	DripAfterPrevious bool      `db:"drip_after_previous"` // drip: открыть после прохождения предыдущего раздела
+	CreatedAt         time.Time `db:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
 }
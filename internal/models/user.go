@@ -7,14 +7,40 @@ import (
 )
 
 type User struct {
-	ID           uuid.UUID  `db:"id"`
-	Email        string     `db:"email"`
-	PasswordHash string     `db:"password_hash"`
+	ID           uuid.UUID  `db:"id" fieldtag:"immutable"`
+	Email        string     `db:"email" fieldtag:"immutable"`
+	PasswordHash string     `db:"password_hash" json:"-" fieldtag:"immutable"` // меняется только через смену пароля в PATCH /me
 	Slug         string     `db:"slug"`
 	FullName     string     `db:"full_name"`
 	AvatarURL    string     `db:"avatar_url"`
-	Role         string     `db:"role"`
+	Role         string     `db:"role" fieldtag:"immutable"` // меняется только командой handbooks user set-role
 	CreatedAt    time.Time  `db:"created_at"`
 	UpdatedAt    time.Time  `db:"updated_at"`
 	LastLoginAt  *time.Time `db:"last_login_at"`
+	TotpSecret   string     `db:"totp_secret" json:"-" fieldtag:"immutable"`
+	TotpEnabled  bool       `db:"totp_enabled" fieldtag:"immutable"`
+}
+
+type RecoveryCode struct {
+	ID        uuid.UUID  `db:"id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	CodeHash  string     `db:"code_hash"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// Роли пользователей
+const (
+	RoleStudent    = "student"
+	RoleInstructor = "instructor"
+	RoleAdmin      = "admin"
+)
+
+// IsValidRole — входит ли роль в список известных
+func IsValidRole(role string) bool {
+	switch role {
+	case RoleStudent, RoleInstructor, RoleAdmin:
+		return true
+	}
+	return false
 }
@@ -0,0 +1,231 @@
+package seed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"handbooks/internal/models"
+	storage "handbooks/pkg/storage"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gosimple/slug"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"gopkg.in/yaml.v3"
+)
+
+// Fixture — демо-данные: курсы с разделами и уроками
+type Fixture struct {
+	// Author — email автора по умолчанию; если пусто, берётся первый администратор
+	Author  string   `yaml:"author" json:"author"`
+	Courses []Course `yaml:"courses" json:"courses"`
+}
+
+type Course struct {
+	Title       string    `yaml:"title" json:"title"`
+	Slug        string    `yaml:"slug" json:"slug"`
+	Subtitle    string    `yaml:"subtitle" json:"subtitle"`
+	Description string    `yaml:"description" json:"description"`
+	CoverURL    string    `yaml:"coverUrl" json:"coverUrl"`
+	Status      string    `yaml:"status" json:"status"`
+	Price       float64   `yaml:"price" json:"price"`
+	Currency    string    `yaml:"currency" json:"currency"`
+	Level       string    `yaml:"level" json:"level"`
+	Author      string    `yaml:"author" json:"author"`
+	Sections    []Section `yaml:"sections" json:"sections"`
+}
+
+type Section struct {
+	Title         string   `yaml:"title" json:"title"`
+	IsFreePreview bool     `yaml:"isFreePreview" json:"isFreePreview"`
+	EstimatedTime int      `yaml:"estimatedTime" json:"estimatedTime"`
+	Lessons       []Lesson `yaml:"lessons" json:"lessons"`
+}
+
+type Lesson struct {
+	Title       string `yaml:"title" json:"title"`
+	Type        string `yaml:"type" json:"type"`
+	Content     string `yaml:"content" json:"content"`
+	DurationSec int    `yaml:"durationSec" json:"durationSec"`
+	IsPublished bool   `yaml:"isPublished" json:"isPublished"`
+}
+
+// Stats — сколько записей создано и сколько курсов пропущено как уже существующие
+type Stats struct {
+	Courses  int
+	Sections int
+	Lessons  int
+	Skipped  int
+}
+
+// Load — читает фикстуру из YAML (.yaml, .yml) или JSON (.json)
+func Load(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f Fixture
+	switch ext := filepath.Ext(path); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &f)
+	case ".json":
+		err = json.Unmarshal(data, &f)
+	default:
+		return nil, fmt.Errorf("неизвестный формат фикстуры %q, ожидается .yaml, .yml или .json", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("не удалось разобрать %s: %w", path, err)
+	}
+
+	return &f, nil
+}
+
+// Apply — загружает фикстуру в одной транзакции. Курсы, чей slug уже есть в базе,
+// пропускаются целиком, поэтому повторный запуск ничего не дублирует.
+func Apply(ctx context.Context, db *pgxpool.Pool, f *Fixture) (*Stats, error) {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	defaultAuthor, err := resolveAuthor(ctx, tx, f.Author)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{}
+	now := time.Now()
+
+	for _, c := range f.Courses {
+		courseSlug := c.Slug
+		if courseSlug == "" {
+			courseSlug = slug.Make(c.Title)
+		}
+
+		_, err := storage.GetOne[models.Course](ctx, tx, "courses", func(sb *sqlbuilder.SelectBuilder) {
+			sb.Where(sb.Equal("slug", courseSlug))
+		})
+		if err == nil {
+			slog.InfoContext(ctx, "Курс уже существует, пропускаем", slog.String("slug", courseSlug))
+			stats.Skipped++
+			continue
+		}
+		if !errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+
+		author := defaultAuthor
+		if c.Author != "" {
+			if author, err = resolveAuthor(ctx, tx, c.Author); err != nil {
+				return nil, err
+			}
+		}
+
+		course := models.Course{
+			ID:          uuid.NewString(),
+			Slug:        courseSlug,
+			Title:       c.Title,
+			Subtitle:    c.Subtitle,
+			Description: c.Description,
+			CoverURL:    c.CoverURL,
+			Status:      valueOr(c.Status, models.CourseStatusDraft),
+			Price:       c.Price,
+			Currency:    valueOr(c.Currency, "EUR"),
+			Level:       valueOr(c.Level, "beginner"),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			CreatedID:   author,
+		}
+		if err := storage.Create(ctx, "courses", course, tx); err != nil {
+			return nil, fmt.Errorf("курс %q: %w", c.Title, err)
+		}
+		stats.Courses++
+
+		courseID := uuid.MustParse(course.ID)
+
+		for i, sec := range c.Sections {
+			section := models.Section{
+				ID:            uuid.New(),
+				CourseID:      courseID,
+				CreatedID:     author,
+				Title:         sec.Title,
+				Slug:          slug.Make(courseSlug + " " + sec.Title),
+				Order:         i + 1,
+				IsFreePreview: sec.IsFreePreview,
+				EstimatedTime: sec.EstimatedTime,
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			}
+			if err := storage.Create(ctx, "sections", section, tx); err != nil {
+				return nil, fmt.Errorf("раздел %q курса %q: %w", sec.Title, c.Title, err)
+			}
+			stats.Sections++
+
+			for j, l := range sec.Lessons {
+				lesson := models.Lesson{
+					ID:          uuid.New(),
+					SectionID:   section.ID,
+					CourseID:    courseID,
+					CreatedID:   author,
+					Title:       l.Title,
+					Slug:        slug.Make(section.Slug + " " + l.Title),
+					Type:        valueOr(l.Type, "text"),
+					Content:     l.Content,
+					Order:       j + 1,
+					DurationSec: l.DurationSec,
+					IsPublished: l.IsPublished,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				}
+				if err := storage.Create(ctx, "lessons", lesson, tx); err != nil {
+					return nil, fmt.Errorf("урок %q раздела %q: %w", l.Title, sec.Title, err)
+				}
+				stats.Lessons++
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// resolveAuthor — id пользователя по email или первого администратора, если email пуст
+func resolveAuthor(ctx context.Context, db storage.Querier, email string) (uuid.UUID, error) {
+	user, err := storage.GetOne[models.User](ctx, db, "users", func(sb *sqlbuilder.SelectBuilder) {
+		if email != "" {
+			sb.Where(sb.Equal("email", email))
+			return
+		}
+		sb.Where(sb.Equal("role", models.RoleAdmin))
+		sb.OrderByAsc("created_at")
+		sb.Limit(1)
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		if email != "" {
+			return uuid.Nil, fmt.Errorf("автор %s не найден", email)
+		}
+		return uuid.Nil, errors.New("нет ни одного администратора: создайте его командой `handbooks user create -role admin` или укажите author в фикстуре")
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return user.ID, nil
+}
+
+func valueOr(v, def string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
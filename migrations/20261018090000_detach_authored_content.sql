@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE courses DROP CONSTRAINT IF EXISTS courses_created_id_fkey;
+ALTER TABLE courses ADD CONSTRAINT courses_created_id_fkey
+    FOREIGN KEY (created_id) REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE sections DROP CONSTRAINT IF EXISTS sections_created_id_fkey;
+ALTER TABLE sections ADD CONSTRAINT sections_created_id_fkey
+    FOREIGN KEY (created_id) REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS lessons_created_id_fkey;
+ALTER TABLE lessons ADD CONSTRAINT lessons_created_id_fkey
+    FOREIGN KEY (created_id) REFERENCES users(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE courses DROP CONSTRAINT IF EXISTS courses_created_id_fkey;
+ALTER TABLE courses ADD CONSTRAINT courses_created_id_fkey
+    FOREIGN KEY (created_id) REFERENCES users(id);
+
+ALTER TABLE sections DROP CONSTRAINT IF EXISTS sections_created_id_fkey;
+ALTER TABLE sections ADD CONSTRAINT sections_created_id_fkey
+    FOREIGN KEY (created_id) REFERENCES users(id);
+
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS lessons_created_id_fkey;
+ALTER TABLE lessons ADD CONSTRAINT lessons_created_id_fkey
+    FOREIGN KEY (created_id) REFERENCES users(id);
+-- +goose StatementEnd
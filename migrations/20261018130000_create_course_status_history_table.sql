@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+UPDATE courses SET status = 'draft' WHERE status IS NULL;
+
+ALTER TABLE courses
+    ALTER COLUMN status SET NOT NULL;
+
+CREATE TABLE IF NOT EXISTS course_status_history (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    course_id   UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    actor_id    UUID REFERENCES users(id) ON DELETE SET NULL,
+    action      VARCHAR(30) NOT NULL,
+    from_status VARCHAR(30) NOT NULL,
+    to_status   VARCHAR(30) NOT NULL,
+    comment     TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS course_status_history_course_id_idx ON course_status_history(course_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS course_status_history;
+
+ALTER TABLE courses
+    ALTER COLUMN status DROP NOT NULL;
+-- +goose StatementEnd
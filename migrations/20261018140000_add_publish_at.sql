@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ;
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ;
+
+-- Планировщик выбирает только записи, ожидающие публикации
+CREATE INDEX IF NOT EXISTS courses_publish_at_idx ON courses(publish_at) WHERE publish_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS lessons_publish_at_idx ON lessons(publish_at) WHERE publish_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS courses_publish_at_idx;
+DROP INDEX IF EXISTS lessons_publish_at_idx;
+
+ALTER TABLE courses DROP COLUMN IF EXISTS publish_at;
+ALTER TABLE lessons DROP COLUMN IF EXISTS publish_at;
+-- +goose StatementEnd
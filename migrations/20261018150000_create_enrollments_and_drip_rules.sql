@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS enrollments (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id      UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    course_id    UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    status       VARCHAR(30) NOT NULL DEFAULT 'active',
+    enrolled_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,
+    UNIQUE (user_id, course_id)
+);
+
+CREATE INDEX IF NOT EXISTS enrollments_course_id_idx ON enrollments(course_id);
+
+CREATE TABLE IF NOT EXISTS lesson_progress (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id          UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    course_id        UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    lesson_id        UUID NOT NULL REFERENCES lessons(id) ON DELETE CASCADE,
+    percent          REAL NOT NULL DEFAULT 0,
+    last_watched_sec INTEGER NOT NULL DEFAULT 0,
+    completed_at     TIMESTAMPTZ,
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, lesson_id)
+);
+
+CREATE INDEX IF NOT EXISTS lesson_progress_user_course_idx ON lesson_progress(user_id, course_id);
+
+-- Правила открытия (drip): через drip_days дней после записи на курс
+-- и/или после прохождения предыдущего раздела
+ALTER TABLE sections
+    ADD COLUMN IF NOT EXISTS drip_days           INTEGER,
+    ADD COLUMN IF NOT EXISTS drip_after_previous BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE lessons
+    ADD COLUMN IF NOT EXISTS drip_days           INTEGER,
+    ADD COLUMN IF NOT EXISTS drip_after_previous BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE lessons
+    DROP COLUMN IF EXISTS drip_days,
+    DROP COLUMN IF EXISTS drip_after_previous;
+
+ALTER TABLE sections
+    DROP COLUMN IF EXISTS drip_days,
+    DROP COLUMN IF EXISTS drip_after_previous;
+
+DROP TABLE IF EXISTS lesson_progress;
+DROP TABLE IF EXISTS enrollments;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Перенумеровываем существующие разделы и уроки 1..n внутри родителя, сохраняя текущий порядок
+UPDATE sections s
+SET "order" = n.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY course_id ORDER BY "order", created_at, id) AS position
+    FROM sections
+) n
+WHERE s.id = n.id;
+
+UPDATE lessons l
+SET "order" = n.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY section_id ORDER BY "order", created_at, id) AS position
+    FROM lessons
+) n
+WHERE l.id = n.id;
+
+ALTER TABLE sections ALTER COLUMN "order" SET NOT NULL;
+ALTER TABLE lessons ALTER COLUMN "order" SET NOT NULL;
+
+-- DEFERRABLE: при перестановке внутри транзакции позиции временно совпадают, проверка — на COMMIT
+ALTER TABLE sections
+    ADD CONSTRAINT sections_course_id_order_key UNIQUE (course_id, "order") DEFERRABLE INITIALLY DEFERRED;
+ALTER TABLE lessons
+    ADD CONSTRAINT lessons_section_id_order_key UNIQUE (section_id, "order") DEFERRABLE INITIALLY DEFERRED;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS lessons_section_id_order_key;
+ALTER TABLE sections DROP CONSTRAINT IF EXISTS sections_course_id_order_key;
+
+ALTER TABLE lessons ALTER COLUMN "order" DROP NOT NULL;
+ALTER TABLE sections ALTER COLUMN "order" DROP NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- slug раздела уникален в пределах курса, slug урока — в пределах раздела
+ALTER TABLE sections DROP CONSTRAINT IF EXISTS sections_slug_key;
+ALTER TABLE sections ADD CONSTRAINT sections_course_id_slug_key UNIQUE (course_id, slug);
+
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS lessons_slug_key;
+ALTER TABLE lessons ADD CONSTRAINT lessons_section_id_slug_key UNIQUE (section_id, slug);
+
+-- Прежние slug после переименования или переноса: по ним отдаётся редирект на текущий.
+-- scope_id — родитель, в пределах которого slug уникален (для курсов — нулевой UUID)
+CREATE TABLE IF NOT EXISTS slug_history (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity      VARCHAR(20) NOT NULL,
+    scope_id    UUID NOT NULL,
+    slug        VARCHAR(120) NOT NULL,
+    target_id   UUID NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (entity, scope_id, slug)
+);
+
+CREATE INDEX IF NOT EXISTS slug_history_target_id_idx ON slug_history(target_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS slug_history;
+
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS lessons_section_id_slug_key;
+ALTER TABLE lessons ADD CONSTRAINT lessons_slug_key UNIQUE (slug);
+
+ALTER TABLE sections DROP CONSTRAINT IF EXISTS sections_course_id_slug_key;
+ALTER TABLE sections ADD CONSTRAINT sections_slug_key UNIQUE (slug);
+-- +goose StatementEnd
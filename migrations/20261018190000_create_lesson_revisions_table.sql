@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS lesson_revisions (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lesson_id     UUID NOT NULL REFERENCES lessons(id) ON DELETE CASCADE,
+    number        INTEGER NOT NULL,
+    author_id     UUID REFERENCES users(id) ON DELETE SET NULL,
+    content       TEXT NOT NULL,
+    restored_from INTEGER,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (lesson_id, number)
+);
+
+-- Ревизии неизменяемы: удалить их можно только вместе с уроком
+CREATE OR REPLACE FUNCTION lesson_revisions_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'lesson revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER lesson_revisions_immutable
+    BEFORE UPDATE ON lesson_revisions
+    FOR EACH ROW EXECUTE FUNCTION lesson_revisions_immutable();
+
+-- Текущее содержимое существующих уроков — их первая ревизия
+INSERT INTO lesson_revisions (lesson_id, number, author_id, content, created_at)
+SELECT id, 1, created_id, content, COALESCE(updated_at, NOW())
+FROM lessons;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS lesson_revisions;
+DROP FUNCTION IF EXISTS lesson_revisions_immutable();
+-- +goose StatementEnd
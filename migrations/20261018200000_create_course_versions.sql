@@ -0,0 +1,124 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Опубликованные версии курса: неизменяемые снимки курса, его разделов и уроков.
+-- Команда курса редактирует рабочую копию (courses, sections, lessons), ученики читают опубликованную версию
+CREATE TABLE IF NOT EXISTS course_versions (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    course_id    UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    number       INTEGER NOT NULL,
+    title        VARCHAR(255) NOT NULL,
+    subtitle     VARCHAR(300) NOT NULL DEFAULT '',
+    description  TEXT NOT NULL DEFAULT '',
+    cover_url    VARCHAR(512) NOT NULL DEFAULT '',
+    price        DECIMAL(10,2) NOT NULL DEFAULT 0,
+    currency     VARCHAR(3) NOT NULL DEFAULT 'EUR',
+    level        VARCHAR(30) NOT NULL DEFAULT '',
+    published_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (course_id, number)
+);
+
+-- Разделы и уроки версии сохраняют id рабочей копии: по нему прогресс ученика переносится между версиями
+CREATE TABLE IF NOT EXISTS course_version_sections (
+    version_id          UUID NOT NULL REFERENCES course_versions(id) ON DELETE CASCADE,
+    id                  UUID NOT NULL,
+    course_id           UUID NOT NULL,
+    created_id          UUID,
+    title               VARCHAR(255) NOT NULL,
+    slug                VARCHAR(120) NOT NULL,
+    "order"             INTEGER NOT NULL,
+    is_free_preview     BOOLEAN,
+    estimated_time      INTEGER,
+    drip_days           INTEGER,
+    drip_after_previous BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at          TIMESTAMPTZ,
+    updated_at          TIMESTAMPTZ,
+    PRIMARY KEY (version_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS course_version_lessons (
+    version_id          UUID NOT NULL REFERENCES course_versions(id) ON DELETE CASCADE,
+    id                  UUID NOT NULL,
+    section_id          UUID NOT NULL,
+    course_id           UUID NOT NULL,
+    created_id          UUID,
+    title               VARCHAR(255) NOT NULL,
+    slug                VARCHAR(120) NOT NULL,
+    type                VARCHAR(50),
+    content             TEXT NOT NULL,
+    "order"             INTEGER NOT NULL,
+    duration_sec        INTEGER,
+    is_published        BOOLEAN,
+    publish_at          TIMESTAMPTZ,
+    drip_days           INTEGER,
+    drip_after_previous BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at          TIMESTAMPTZ,
+    updated_at          TIMESTAMPTZ,
+    PRIMARY KEY (version_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS course_version_lessons_section_idx ON course_version_lessons(version_id, section_id);
+
+-- Версии неизменяемы: удалить их можно только вместе с курсом
+CREATE OR REPLACE FUNCTION course_versions_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'course versions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER course_versions_immutable
+    BEFORE UPDATE ON course_versions
+    FOR EACH ROW EXECUTE FUNCTION course_versions_immutable();
+CREATE TRIGGER course_version_sections_immutable
+    BEFORE UPDATE ON course_version_sections
+    FOR EACH ROW EXECUTE FUNCTION course_versions_immutable();
+CREATE TRIGGER course_version_lessons_immutable
+    BEFORE UPDATE ON course_version_lessons
+    FOR EACH ROW EXECUTE FUNCTION course_versions_immutable();
+
+ALTER TABLE courses
+    ADD COLUMN IF NOT EXISTS published_version_id UUID REFERENCES course_versions(id) ON DELETE SET NULL;
+
+-- Прогресс привязан к id урока, а не к строке рабочей копии: урок, удалённый из черновика,
+-- остаётся в опубликованной версии вместе с прогрессом учеников
+ALTER TABLE lesson_progress DROP CONSTRAINT IF EXISTS lesson_progress_lesson_id_fkey;
+
+-- Уже опубликованные курсы получают первую версию из текущего содержимого
+INSERT INTO course_versions (id, course_id, number, title, subtitle, description, cover_url, price, currency, level, created_at)
+SELECT gen_random_uuid(), id, 1, title, COALESCE(subtitle, ''), COALESCE(description, ''), COALESCE(cover_url, ''),
+       COALESCE(price, 0), COALESCE(currency, 'EUR'), COALESCE(level, ''), NOW()
+FROM courses
+WHERE status = 'published';
+
+UPDATE courses c SET published_version_id = v.id
+FROM course_versions v
+WHERE v.course_id = c.id;
+
+INSERT INTO course_version_sections (version_id, id, course_id, created_id, title, slug, "order", is_free_preview,
+                                     estimated_time, drip_days, drip_after_previous, created_at, updated_at)
+SELECT v.id, s.id, s.course_id, s.created_id, s.title, s.slug, s."order", s.is_free_preview,
+       s.estimated_time, s.drip_days, s.drip_after_previous, s.created_at, s.updated_at
+FROM sections s
+JOIN course_versions v ON v.course_id = s.course_id;
+
+INSERT INTO course_version_lessons (version_id, id, section_id, course_id, created_id, title, slug, type, content, "order",
+                                    duration_sec, is_published, publish_at, drip_days, drip_after_previous, created_at, updated_at)
+SELECT v.id, l.id, l.section_id, l.course_id, l.created_id, l.title, l.slug, l.type, l.content, l."order",
+       l.duration_sec, l.is_published, l.publish_at, l.drip_days, l.drip_after_previous, l.created_at, l.updated_at
+FROM lessons l
+JOIN course_versions v ON v.course_id = l.course_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM lesson_progress p WHERE NOT EXISTS (SELECT 1 FROM lessons l WHERE l.id = p.lesson_id);
+ALTER TABLE lesson_progress ADD CONSTRAINT lesson_progress_lesson_id_fkey
+    FOREIGN KEY (lesson_id) REFERENCES lessons(id) ON DELETE CASCADE;
+
+ALTER TABLE courses DROP COLUMN IF EXISTS published_version_id;
+
+DROP TABLE IF EXISTS course_version_lessons;
+DROP TABLE IF EXISTS course_version_sections;
+DROP TABLE IF EXISTS course_versions;
+DROP FUNCTION IF EXISTS course_versions_immutable();
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ON DELETE SET NULL по author_id выполняется как UPDATE строки ревизии. Триггер пропускает
+-- только такое изменение — обнуление колонки из аргумента триггера, — иначе удалить
+-- пользователя, писавшего уроки, было бы невозможно
+CREATE OR REPLACE FUNCTION lesson_revisions_immutable() RETURNS trigger AS $$
+BEGIN
+    IF TG_NARGS = 1
+       AND to_jsonb(OLD) ->> TG_ARGV[0] IS NOT NULL
+       AND to_jsonb(NEW) ->> TG_ARGV[0] IS NULL
+       AND to_jsonb(NEW) - TG_ARGV[0] = to_jsonb(OLD) - TG_ARGV[0] THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'lesson revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS lesson_revisions_immutable ON lesson_revisions;
+CREATE TRIGGER lesson_revisions_immutable
+    BEFORE UPDATE ON lesson_revisions
+    FOR EACH ROW EXECUTE FUNCTION lesson_revisions_immutable('author_id');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS lesson_revisions_immutable ON lesson_revisions;
+
+CREATE OR REPLACE FUNCTION lesson_revisions_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'lesson revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER lesson_revisions_immutable
+    BEFORE UPDATE ON lesson_revisions
+    FOR EACH ROW EXECUTE FUNCTION lesson_revisions_immutable();
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ON DELETE SET NULL по published_by выполняется как UPDATE строки версии. Как и для ревизий уроков,
+-- триггер пропускает только обнуление колонки из своего аргумента, иначе нельзя удалить того,
+-- кто публиковал курс. Разделы и уроки версии ссылок на пользователей не имеют и остаются без аргумента
+CREATE OR REPLACE FUNCTION course_versions_immutable() RETURNS trigger AS $$
+BEGIN
+    IF TG_NARGS = 1
+       AND to_jsonb(OLD) ->> TG_ARGV[0] IS NOT NULL
+       AND to_jsonb(NEW) ->> TG_ARGV[0] IS NULL
+       AND to_jsonb(NEW) - TG_ARGV[0] = to_jsonb(OLD) - TG_ARGV[0] THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'course versions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS course_versions_immutable ON course_versions;
+CREATE TRIGGER course_versions_immutable
+    BEFORE UPDATE ON course_versions
+    FOR EACH ROW EXECUTE FUNCTION course_versions_immutable('published_by');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS course_versions_immutable ON course_versions;
+
+CREATE OR REPLACE FUNCTION course_versions_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'course versions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER course_versions_immutable
+    BEFORE UPDATE ON course_versions
+    FOR EACH ROW EXECUTE FUNCTION course_versions_immutable();
+-- +goose StatementEnd
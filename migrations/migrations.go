@@ -0,0 +1,8 @@
+// Package migrations содержит SQL-миграции goose, вшитые в бинарник:
+// версия схемы, которую ожидает приложение, не зависит от файлов рядом с ним.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"slices"
+	"sort"
 
 	"github.com/huandu/go-sqlbuilder"
 	"github.com/jackc/pgx/v5"
@@ -19,12 +21,19 @@ type Querier interface {
 var ErrNotFound = errors.New("not found")
 
 // GetAll функция для получения всех записей из базы данных
-func GetAll[T any](ctx context.Context, table string, db Querier, opts ...func(*sqlbuilder.SelectBuilder)) ([]T, error) {
-	sb := sqlbuilder.NewStruct(new(T)).SelectFrom(table)
+func GetAll[T any](ctx context.Context, table string, db Querier, opts ...func(*sqlbuilder.SelectBuilder)) (_ []T, err error) {
+	ctx, span := startSpan(ctx, "SELECT", table)
+	defer func() { endSpan(span, err) }()
+
+	structs := sqlbuilder.NewStruct(new(T)).For(sqlbuilder.PostgreSQL)
+	sb := structs.SelectFrom(table)
 
 	sb.From(table)
 
-	sb.OrderByDesc("created_at")
+	// Сортировка по умолчанию — только у таблиц с created_at (у enrollments, lesson_progress её нет)
+	if slices.Contains(structs.Columns(), "created_at") {
+		sb.OrderByDesc("created_at")
+	}
 
 	for _, opt := range opts {
 		opt(sb)
@@ -36,7 +45,6 @@ func GetAll[T any](ctx context.Context, table string, db Querier, opts ...func(*
 	if err != nil {
 		slog.ErrorContext(ctx, "cannot execute get all query",
 			slog.String("query", query),
-			slog.Any("args", args),
 			slog.String("error", err.Error()),
 		)
 		return nil, err
@@ -56,7 +64,10 @@ func GetAll[T any](ctx context.Context, table string, db Querier, opts ...func(*
 }
 
 // GetOne функция для получения одной записи из базы данных
-func GetOne[T any](ctx context.Context, db Querier, table string, opts ...func(*sqlbuilder.SelectBuilder)) (*T, error) {
+func GetOne[T any](ctx context.Context, db Querier, table string, opts ...func(*sqlbuilder.SelectBuilder)) (_ *T, err error) {
+	ctx, span := startSpan(ctx, "SELECT", table)
+	defer func() { endSpan(span, err) }()
+
 	itemsStruct := sqlbuilder.NewStruct(new(T)).For(sqlbuilder.PostgreSQL)
 
 	sb := itemsStruct.SelectFrom(table)
@@ -69,7 +80,7 @@ func GetOne[T any](ctx context.Context, db Querier, table string, opts ...func(*
 
 	rows, err := db.Query(ctx, query, args...)
 	if err != nil {
-		slog.ErrorContext(ctx, "query failed", "query", query, "args", args, "err", err)
+		slog.ErrorContext(ctx, "query failed", "query", query, "err", err)
 		return nil, err
 	}
 	defer rows.Close()
@@ -79,7 +90,7 @@ func GetOne[T any](ctx context.Context, db Querier, table string, opts ...func(*
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
 		}
-		slog.ErrorContext(ctx, "cannot collect row", "query", query, "args", args, "err", err)
+		slog.ErrorContext(ctx, "cannot collect row", "query", query, "err", err)
 		return nil, err
 	}
 
@@ -87,22 +98,22 @@ func GetOne[T any](ctx context.Context, db Querier, table string, opts ...func(*
 }
 
 // Create функция для создания записи в базе данных
-func Create[T any](ctx context.Context, table string, item T, db Querier, opts ...func(*sqlbuilder.SelectBuilder)) error {
-	structs := sqlbuilder.NewStruct(new(T))
+func Create[T any](ctx context.Context, table string, item T, db Querier, opts ...func(*sqlbuilder.SelectBuilder)) (err error) {
+	ctx, span := startSpan(ctx, "INSERT", table)
+	defer func() { endSpan(span, err) }()
 
-	slog.Info("Items", slog.Any("Item", item))
+	structs := sqlbuilder.NewStruct(new(T))
 
 	sb := structs.WithoutTag("db", "-").InsertInto(table, item)
 	sb.SetFlavor(sqlbuilder.PostgreSQL)
 
 	query, args := sb.Build()
 
-	slog.Info("Query", slog.String("query", query), slog.Any("args", args))
+	slog.DebugContext(ctx, "insert", slog.String("table", table), slog.String("query", query))
 
 	if _, err := db.Exec(ctx, query, args...); err != nil {
 		slog.ErrorContext(ctx, "cannot create item",
 			slog.String("query", query),
-			slog.Any("args", args),
 			slog.String("error", err.Error()),
 		)
 		return err
@@ -112,7 +123,10 @@ func Create[T any](ctx context.Context, table string, item T, db Querier, opts .
 }
 
 // Update функция для обновления записи в базе данных
-func Update[T any](ctx context.Context, table string, item T, db Querier, opts ...func(*sqlbuilder.UpdateBuilder)) error {
+func Update[T any](ctx context.Context, table string, item T, db Querier, opts ...func(*sqlbuilder.UpdateBuilder)) (err error) {
+	ctx, span := startSpan(ctx, "UPDATE", table)
+	defer func() { endSpan(span, err) }()
+
 	structs := sqlbuilder.NewStruct(new(T))
 
 	sb := structs.WithoutTag("db", "-").WithoutTag("immutable").Update(table, item)
@@ -127,7 +141,41 @@ func Update[T any](ctx context.Context, table string, item T, db Querier, opts .
 	if _, err := db.Exec(ctx, query, args...); err != nil {
 		slog.ErrorContext(ctx, "cannot update item",
 			slog.String("query", query),
-			slog.Any("args", args),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateFields функция для частичного обновления записи в базе данных
+func UpdateFields(ctx context.Context, table string, fields map[string]any, db Querier, opts ...func(*sqlbuilder.UpdateBuilder)) (err error) {
+	ctx, span := startSpan(ctx, "UPDATE", table)
+	defer func() { endSpan(span, err) }()
+
+	ub := sqlbuilder.PostgreSQL.NewUpdateBuilder()
+	ub.Update(table)
+
+	columns := make([]string, 0, len(fields))
+	for column := range fields {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	for _, column := range columns {
+		ub.SetMore(ub.Assign(column, fields[column]))
+	}
+
+	for _, opt := range opts {
+		opt(ub)
+	}
+
+	query, args := ub.Build()
+
+	if _, err := db.Exec(ctx, query, args...); err != nil {
+		slog.ErrorContext(ctx, "cannot update fields",
+			slog.String("query", query),
 			slog.String("error", err.Error()),
 		)
 		return err
@@ -137,18 +185,29 @@ func Update[T any](ctx context.Context, table string, item T, db Querier, opts .
 }
 
 // Delete функция для удаления записи из базы данных
-func Delete[T any](ctx context.Context, table string, db Querier, opts ...func(*sqlbuilder.SelectBuilder)) error {
+func Delete[T any](ctx context.Context, table string, db Querier, opts ...func(*sqlbuilder.SelectBuilder)) (err error) {
+	ctx, span := startSpan(ctx, "DELETE", table)
+	defer func() { endSpan(span, err) }()
+
 	structs := sqlbuilder.NewStruct(new(T))
 
 	sb := structs.WithoutTag("db", "-").DeleteFrom(table)
 	sb.SetFlavor(sqlbuilder.PostgreSQL)
 
+	// Условия описываются через SelectBuilder, переносим его WHERE в DELETE
+	where := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	for _, opt := range opts {
+		opt(where)
+	}
+	if where.WhereClause != nil {
+		sb.AddWhereClause(where.WhereClause)
+	}
+
 	query, args := sb.Build()
 
 	if _, err := db.Exec(ctx, query, args...); err != nil {
 		slog.ErrorContext(ctx, "cannot delete item",
 			slog.String("query", query),
-			slog.Any("args", args),
 			slog.String("error", err.Error()),
 		)
 		return err